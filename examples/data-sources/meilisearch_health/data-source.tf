@@ -0,0 +1,9 @@
+# Continuously check that the Meilisearch server is available
+check "meilisearch_health" {
+  data "meilisearch_health" "example" {}
+
+  assert {
+    condition     = data.meilisearch_health.example.available
+    error_message = "Meilisearch is ${data.meilisearch_health.example.status}."
+  }
+}
@@ -0,0 +1,13 @@
+# Retrieve the settings of a Meilisearch index
+data "meilisearch_index_settings" "example" {
+  index_uid = "example"
+}
+
+# Reuse the filterable and sortable attributes of the index
+output "filterable_attributes" {
+  value = data.meilisearch_index_settings.example.filterable_attributes
+}
+
+output "sortable_attributes" {
+  value = data.meilisearch_index_settings.example.sortable_attributes
+}
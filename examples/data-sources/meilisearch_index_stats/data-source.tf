@@ -0,0 +1,14 @@
+# Retrieve the statistics of a Meilisearch index
+data "meilisearch_index_stats" "example" {
+  index_uid = "example"
+}
+
+# Only swap indexes once the new index is populated
+resource "terraform_data" "swap" {
+  lifecycle {
+    precondition {
+      condition     = data.meilisearch_index_stats.example.number_of_documents > 1000
+      error_message = "The example index must contain more than 1000 documents."
+    }
+  }
+}
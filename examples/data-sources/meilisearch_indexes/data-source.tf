@@ -0,0 +1,13 @@
+# Retrieve every Meilisearch index of a tenant
+data "meilisearch_indexes" "tenants" {
+  uid_prefix = "tenant_"
+}
+
+# Create a search key for each of them
+resource "meilisearch_key" "tenant" {
+  for_each = { for index in data.meilisearch_indexes.tenants.indexes : index.uid => index }
+
+  name    = "${each.key} search key"
+  actions = ["search"]
+  indexes = [each.key]
+}
@@ -2,3 +2,8 @@
 data "meilisearch_key" "example" {
   uid = "11111111-2222-3333-4444-555555555555"
 }
+
+# Retrieve Meilisearch key by name
+data "meilisearch_key" "default_search" {
+  name = "Default Search API Key"
+}
@@ -0,0 +1,6 @@
+# Retrieve every valid Meilisearch key allowed to search the movies index
+data "meilisearch_keys" "search" {
+  action  = "search"
+  index   = "movies"
+  expired = false
+}
@@ -0,0 +1,2 @@
+# Retrieve the global statistics of the Meilisearch database
+data "meilisearch_stats" "example" {}
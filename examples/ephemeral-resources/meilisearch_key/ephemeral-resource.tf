@@ -0,0 +1,7 @@
+# Create a short-lived search key, revoked at the end of the Terraform run
+ephemeral "meilisearch_key" "example" {
+  name    = "ci search key"
+  actions = ["search"]
+  indexes = ["movies"]
+  ttl     = "30m"
+}
@@ -0,0 +1,4 @@
+# Read the secret of an existing key without storing it in the state
+ephemeral "meilisearch_key_secret" "default_search" {
+  name = "Default Search API Key"
+}
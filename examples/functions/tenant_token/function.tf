@@ -0,0 +1,12 @@
+# Generate a tenant token restricting searches to the documents of a user
+output "tenant_token" {
+  value = provider::meilisearch::tenant_token(
+    meilisearch_key.search.uid,
+    meilisearch_key.search.key,
+    {
+      movies = { filter = "user_id = 42" }
+    },
+    "2030-01-01T00:00:00Z",
+  )
+  sensitive = true
+}
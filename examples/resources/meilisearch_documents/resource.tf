@@ -0,0 +1,19 @@
+# Manage documents of a Meilisearch Index
+resource "meilisearch_documents" "example" {
+  index_uid   = meilisearch_index.example.uid
+  primary_key = "id"
+
+  documents = [
+    jsonencode({ id = 1, title = "Carol", genres = ["Romance", "Drama"] }),
+    jsonencode({ id = 2, title = "Wonder Woman", genres = ["Action", "Adventure"] }),
+  ]
+}
+
+# Manage documents loaded from a JSON array
+resource "meilisearch_documents" "movies" {
+  index_uid      = meilisearch_index.example.uid
+  primary_key    = "id"
+  documents_json = file("${path.module}/movies.json")
+  partial_update = true
+  batch_size     = 500
+}
@@ -0,0 +1,16 @@
+# Load documents from a NDJSON file
+resource "meilisearch_documents_file" "example" {
+  index_uid   = meilisearch_index.example.uid
+  primary_key = "id"
+  path        = "${path.module}/movies.ndjson"
+  batch_size  = 5000
+}
+
+# Load documents from a CSV file using semicolons as separators
+resource "meilisearch_documents_file" "csv" {
+  index_uid     = meilisearch_index.example.uid
+  primary_key   = "id"
+  path          = "${path.module}/movies.txt"
+  format        = "csv"
+  csv_delimiter = ";"
+}
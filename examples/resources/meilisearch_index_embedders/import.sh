@@ -0,0 +1,2 @@
+# Index embedders can be imported by specifying the UID of the index.
+terraform import meilisearch_index_embedders.example index-uid
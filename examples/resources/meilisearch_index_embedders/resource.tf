@@ -0,0 +1,31 @@
+# Manage the embedders of a Meilisearch Index
+resource "meilisearch_index_embedders" "example" {
+  index_uid = meilisearch_index.example.uid
+
+  embedders = {
+    default = {
+      source            = "openAi"
+      model             = "text-embedding-3-small"
+      api_key           = var.openai_api_key
+      document_template = "A movie titled '{{doc.title}}' whose description starts with {{doc.overview|truncatewords: 20}}"
+    }
+
+    custom = {
+      source     = "rest"
+      url        = "https://embeddings.example.com/embed"
+      api_key    = var.embeddings_api_key
+      dimensions = 768
+      request = jsonencode({
+        input = ["{{text}}", "{{..}}"]
+      })
+      response = jsonencode({
+        data = [{ embedding = "{{embedding}}" }, "{{..}}"]
+      })
+    }
+
+    manual = {
+      source     = "userProvided"
+      dimensions = 3
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Index faceting can be imported by specifying the UID of the index.
+terraform import meilisearch_index_faceting.example index-uid
@@ -0,0 +1,10 @@
+# Manage the faceting settings of a Meilisearch Index
+resource "meilisearch_index_faceting" "example" {
+  index_uid            = meilisearch_index.example.uid
+  max_values_per_facet = 500
+
+  sort_facet_values_by = {
+    "*"    = "alpha"
+    "size" = "count"
+  }
+}
@@ -0,0 +1,2 @@
+# Index localized attributes can be imported by specifying the UID of the index.
+terraform import meilisearch_index_localized_attributes.example index-uid
@@ -0,0 +1,15 @@
+# Manage the locales of the attributes of a Meilisearch Index
+resource "meilisearch_index_localized_attributes" "example" {
+  index_uid = meilisearch_index.example.uid
+
+  localized_attributes = [
+    {
+      attribute_patterns = ["*_ja"]
+      locales            = ["jpn"]
+    },
+    {
+      attribute_patterns = ["*_fr", "*_be"]
+      locales            = ["fra", "nld"]
+    },
+  ]
+}
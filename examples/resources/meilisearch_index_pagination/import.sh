@@ -0,0 +1,2 @@
+# Index pagination can be imported by specifying the UID of the index.
+terraform import meilisearch_index_pagination.example index-uid
@@ -0,0 +1,5 @@
+# Manage the pagination settings of a Meilisearch Index
+resource "meilisearch_index_pagination" "example" {
+  index_uid      = meilisearch_index.example.uid
+  max_total_hits = 5000
+}
@@ -0,0 +1,2 @@
+# Index performance settings can be imported by specifying the UID of the index.
+terraform import meilisearch_index_performance_settings.example index-uid
@@ -0,0 +1,7 @@
+# Manage the performance settings of a Meilisearch Index. Settings left out,
+# such as facet_search here, are not modified.
+resource "meilisearch_index_performance_settings" "example" {
+  index_uid        = meilisearch_index.example.uid
+  search_cutoff_ms = 150
+  prefix_search    = "disabled"
+}
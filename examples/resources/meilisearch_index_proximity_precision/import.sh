@@ -0,0 +1,2 @@
+# Index proximity precision can be imported by specifying the UID of the index.
+terraform import meilisearch_index_proximity_precision.example index-uid
@@ -0,0 +1,5 @@
+# Manage the proximity precision of a Meilisearch Index
+resource "meilisearch_index_proximity_precision" "example" {
+  index_uid           = meilisearch_index.example.uid
+  proximity_precision = "byAttribute"
+}
@@ -0,0 +1,2 @@
+# Index ranking rules can be imported by specifying the UID of the index.
+terraform import meilisearch_index_ranking_rules.example index-uid
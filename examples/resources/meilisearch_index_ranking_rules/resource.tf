@@ -0,0 +1,14 @@
+# Manage the ranking rules of a Meilisearch Index
+resource "meilisearch_index_ranking_rules" "example" {
+  index_uid = meilisearch_index.example.uid
+
+  ranking_rules = [
+    "words",
+    "typo",
+    "proximity",
+    "attribute",
+    "sort",
+    "exactness",
+    "release_date:desc",
+  ]
+}
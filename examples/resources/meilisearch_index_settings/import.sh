@@ -0,0 +1,2 @@
+# Index settings can be imported by specifying the UID of the index.
+terraform import meilisearch_index_settings.example index-uid
@@ -0,0 +1,24 @@
+# Manage the settings of a Meilisearch Index
+resource "meilisearch_index_settings" "example" {
+  index_uid = meilisearch_index.example.uid
+
+  searchable_attributes = ["title", "overview"]
+  filterable_attributes = ["genres", "release_date"]
+  sortable_attributes   = ["release_date"]
+  stop_words            = ["the", "a", "an"]
+
+  synonyms = {
+    "phone" = ["iphone", "smartphone"]
+  }
+
+  typo_tolerance = {
+    min_word_size_for_typos = {
+      one_typo  = 4
+      two_typos = 10
+    }
+  }
+
+  pagination = {
+    max_total_hits = 5000
+  }
+}
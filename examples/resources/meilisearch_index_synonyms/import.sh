@@ -0,0 +1,2 @@
+# Index synonyms can be imported by specifying the UID of the index.
+terraform import meilisearch_index_synonyms.example index-uid
@@ -0,0 +1,14 @@
+# Manage the synonyms of a Meilisearch Index
+resource "meilisearch_index_synonyms" "example" {
+  index_uid = meilisearch_index.example.uid
+
+  # One-way synonyms: searching "phone" also matches "iphone", but not the other way around
+  synonyms = {
+    phone = ["iphone"]
+  }
+
+  # Mutual synonyms: each term matches every other term of the group
+  group {
+    terms = ["sweater", "pullover", "jumper"]
+  }
+}
@@ -0,0 +1,2 @@
+# Index tokenization can be imported by specifying the UID of the index.
+terraform import meilisearch_index_tokenization.example index-uid
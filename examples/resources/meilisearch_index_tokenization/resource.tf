@@ -0,0 +1,8 @@
+# Manage the tokenization settings of a Meilisearch Index
+resource "meilisearch_index_tokenization" "example" {
+  index_uid = meilisearch_index.example.uid
+
+  dictionary           = ["C++", "C#"]
+  separator_tokens     = ["|"]
+  non_separator_tokens = ["-"]
+}
@@ -0,0 +1,2 @@
+# Index typo tolerance can be imported by specifying the UID of the index.
+terraform import meilisearch_index_typo_tolerance.example index-uid
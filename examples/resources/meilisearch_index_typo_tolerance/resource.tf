@@ -0,0 +1,12 @@
+# Manage the typo tolerance of a Meilisearch Index
+resource "meilisearch_index_typo_tolerance" "example" {
+  index_uid = meilisearch_index.example.uid
+
+  min_word_size_for_typos = {
+    one_typo  = 4
+    two_typos = 8
+  }
+
+  disable_on_words      = ["shrek"]
+  disable_on_attributes = ["title"]
+}
@@ -0,0 +1,193 @@
+// Package apierror classifies errors returned by the Meilisearch Go SDK.
+//
+// Every SDK method returns a *meilisearch.Error, possibly wrapped. The helpers
+// below unwrap it and rely on the HTTP status and the Meilisearch error code
+// rather than on the formatted error message, which is not stable across SDK
+// releases.
+package apierror
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Meilisearch error codes, see https://www.meilisearch.com/docs/reference/errors/error_codes.
+const (
+	CodeIndexNotFound              = "index_not_found"
+	CodeIndexAlreadyExists         = "index_already_exists"
+	CodeDocumentNotFound           = "document_not_found"
+	CodeAPIKeyNotFound             = "api_key_not_found"
+	CodeAPIKeyAlreadyExists        = "api_key_already_exists"
+	CodeInvalidAPIKey              = "invalid_api_key"
+	CodeMissingAuthorizationHeader = "missing_authorization_header"
+)
+
+// As returns the Meilisearch error wrapped in err, if any.
+func As(err error) (*meilisearch.Error, bool) {
+	var meiliErr *meilisearch.Error
+
+	if errors.As(err, &meiliErr) {
+		return meiliErr, true
+	}
+
+	return nil, false
+}
+
+// StatusCode returns the HTTP status code of the response that produced err,
+// or 0 when no response was received.
+func StatusCode(err error) int {
+	if meiliErr, ok := As(err); ok {
+		return meiliErr.StatusCode
+	}
+
+	return 0
+}
+
+// Code returns the Meilisearch error code (for instance "index_not_found"),
+// or an empty string when err does not come from the Meilisearch API.
+func Code(err error) string {
+	if meiliErr, ok := As(err); ok {
+		return meiliErr.MeilisearchApiError.Code
+	}
+
+	return ""
+}
+
+// HasCode reports whether err carries one of the given Meilisearch error codes.
+func HasCode(err error, codes ...string) bool {
+	code := Code(err)
+	if code == "" {
+		return false
+	}
+
+	for _, c := range codes {
+		if code == c {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsNotFound reports whether err means the requested object does not exist.
+// A 404 without a Meilisearch error code, such as the one answered by a
+// reverse proxy for a wrong path, is not enough: resources would otherwise be
+// removed from the state while they still exist.
+func IsNotFound(err error) bool {
+	return strings.HasSuffix(Code(err), "_not_found")
+}
+
+// IsUnauthorized reports whether err was caused by a missing, invalid or
+// insufficiently privileged API key.
+func IsUnauthorized(err error) bool {
+	switch StatusCode(err) {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return true
+	}
+
+	return HasCode(err, CodeInvalidAPIKey, CodeMissingAuthorizationHeader)
+}
+
+// IsUnauthenticated reports whether err was caused by a missing API key.
+// Unlike IsUnauthorized, it does not match the 403 invalid_api_key error,
+// which Meilisearch answers both for unknown keys and for valid keys lacking
+// the requested action.
+func IsUnauthenticated(err error) bool {
+	return StatusCode(err) == http.StatusUnauthorized || HasCode(err, CodeMissingAuthorizationHeader)
+}
+
+// IsConflict reports whether err means the object already exists.
+func IsConflict(err error) bool {
+	return StatusCode(err) == http.StatusConflict || strings.HasSuffix(Code(err), "_already_exists")
+}
+
+// IsTransient reports whether the request may succeed if retried: the server
+// could not be reached, timed out, or answered with a temporary failure.
+func IsTransient(err error) bool {
+	meiliErr, ok := As(err)
+	if !ok {
+		return false
+	}
+
+	switch meiliErr.ErrCode {
+	case meilisearch.MeilisearchCommunicationError, meilisearch.MeilisearchTimeoutError, meilisearch.MeilisearchMaxRetriesExceeded:
+		return true
+	}
+
+	switch meiliErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// IsUnreachable reports whether the request failed before any response was
+// received from the server.
+func IsUnreachable(err error) bool {
+	meiliErr, ok := As(err)
+	if !ok {
+		return false
+	}
+
+	switch meiliErr.ErrCode {
+	case meilisearch.MeilisearchCommunicationError, meilisearch.MeilisearchTimeoutError:
+		return true
+	}
+
+	return false
+}
+
+// IsTLS reports whether the request failed during the TLS handshake, for
+// instance because the server certificate is not trusted or because the
+// server does not speak TLS.
+func IsTLS(err error) bool {
+	if meiliErr, ok := As(err); ok {
+		// The SDK error does not implement Unwrap
+		if meiliErr.OriginError == nil {
+			return false
+		}
+
+		err = meiliErr.OriginError
+	}
+
+	var (
+		verificationErr *tls.CertificateVerificationError
+		recordHeaderErr tls.RecordHeaderError
+		alertErr        tls.AlertError
+		unknownAuthErr  x509.UnknownAuthorityError
+		hostnameErr     x509.HostnameError
+		invalidCertErr  x509.CertificateInvalidError
+	)
+
+	return errors.As(err, &verificationErr) ||
+		errors.As(err, &recordHeaderErr) ||
+		errors.As(err, &alertErr) ||
+		errors.As(err, &unknownAuthErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidCertErr)
+}
+
+// Describe formats err for diagnostics, naming the Meilisearch error code and
+// HTTP status when available.
+func Describe(err error) string {
+	meiliErr, ok := As(err)
+	if !ok || meiliErr.MeilisearchApiError.Code == "" {
+		return err.Error()
+	}
+
+	apiErr := meiliErr.MeilisearchApiError
+
+	description := fmt.Sprintf("%s (code: %s, HTTP status: %d)", apiErr.Message, apiErr.Code, meiliErr.StatusCode)
+	if apiErr.Link != "" {
+		description += ". See " + apiErr.Link
+	}
+
+	return description
+}
@@ -0,0 +1,175 @@
+package apierror
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+func apiError(statusCode int, code string) *meilisearch.Error {
+	err := &meilisearch.Error{StatusCode: statusCode, ErrCode: meilisearch.MeilisearchApiError}
+	err.MeilisearchApiError.Code = code
+	err.MeilisearchApiError.Message = "message"
+	err.MeilisearchApiError.Link = "https://docs.meilisearch.com/errors#" + code
+
+	return err
+}
+
+func TestClassification(t *testing.T) {
+	testCases := map[string]struct {
+		err             error
+		notFound        bool
+		unauthorized    bool
+		unauthenticated bool
+		conflict        bool
+		transient       bool
+	}{
+		"index not found": {
+			err:      apiError(404, CodeIndexNotFound),
+			notFound: true,
+		},
+		"wrapped api key not found": {
+			err:      fmt.Errorf("wrapped: %w", apiError(404, CodeAPIKeyNotFound)),
+			notFound: true,
+		},
+		"proxy not found": {
+			err: &meilisearch.Error{StatusCode: 404, ErrCode: meilisearch.MeilisearchApiErrorWithoutMessage},
+		},
+		"route not found": {
+			err: apiError(404, ""),
+		},
+		"invalid api key": {
+			err:          apiError(403, CodeInvalidAPIKey),
+			unauthorized: true,
+		},
+		"missing authorization header": {
+			err:             apiError(401, CodeMissingAuthorizationHeader),
+			unauthorized:    true,
+			unauthenticated: true,
+		},
+		"index already exists": {
+			err:      apiError(409, CodeIndexAlreadyExists),
+			conflict: true,
+		},
+		"bad gateway": {
+			err:       &meilisearch.Error{StatusCode: 502, ErrCode: meilisearch.MeilisearchApiErrorWithoutMessage},
+			transient: true,
+		},
+		"communication error": {
+			err:       &meilisearch.Error{ErrCode: meilisearch.MeilisearchCommunicationError},
+			transient: true,
+		},
+		"invalid request": {
+			err: apiError(400, "invalid_settings_ranking_rules"),
+		},
+		"other error": {
+			err: errors.New("index_not_found,"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsNotFound(testCase.err); got != testCase.notFound {
+				t.Errorf("IsNotFound() = %t, expected %t", got, testCase.notFound)
+			}
+			if got := IsUnauthorized(testCase.err); got != testCase.unauthorized {
+				t.Errorf("IsUnauthorized() = %t, expected %t", got, testCase.unauthorized)
+			}
+			if got := IsUnauthenticated(testCase.err); got != testCase.unauthenticated {
+				t.Errorf("IsUnauthenticated() = %t, expected %t", got, testCase.unauthenticated)
+			}
+			if got := IsConflict(testCase.err); got != testCase.conflict {
+				t.Errorf("IsConflict() = %t, expected %t", got, testCase.conflict)
+			}
+			if got := IsTransient(testCase.err); got != testCase.transient {
+				t.Errorf("IsTransient() = %t, expected %t", got, testCase.transient)
+			}
+		})
+	}
+}
+
+func TestCodeAndStatusCode(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", apiError(404, CodeIndexNotFound))
+
+	if code := Code(err); code != CodeIndexNotFound {
+		t.Errorf("Code() = %q, expected %q", code, CodeIndexNotFound)
+	}
+
+	if statusCode := StatusCode(err); statusCode != 404 {
+		t.Errorf("StatusCode() = %d, expected 404", statusCode)
+	}
+
+	if !HasCode(err, CodeAPIKeyNotFound, CodeIndexNotFound) {
+		t.Error("HasCode() = false, expected true")
+	}
+
+	if code := Code(errors.New("other")); code != "" {
+		t.Errorf("Code() = %q, expected empty code", code)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	expected := "message (code: index_not_found, HTTP status: 404). See https://docs.meilisearch.com/errors#index_not_found"
+
+	if description := Describe(apiError(404, CodeIndexNotFound)); description != expected {
+		t.Errorf("Describe() = %q, expected %q", description, expected)
+	}
+
+	if description := Describe(errors.New("other")); description != "other" {
+		t.Errorf("Describe() = %q, expected %q", description, "other")
+	}
+}
+
+func TestConnectionFailures(t *testing.T) {
+	communicationError := func(origin error) error {
+		return &meilisearch.Error{ErrCode: meilisearch.MeilisearchCommunicationError, OriginError: origin}
+	}
+
+	testCases := map[string]struct {
+		err         error
+		unreachable bool
+		tls         bool
+	}{
+		"connection refused": {
+			err:         communicationError(&url.Error{Op: "Get", URL: "http://localhost:7700/health", Err: errors.New("connection refused")}),
+			unreachable: true,
+		},
+		"timeout": {
+			err:         &meilisearch.Error{ErrCode: meilisearch.MeilisearchTimeoutError},
+			unreachable: true,
+		},
+		"unknown certificate authority": {
+			err:         communicationError(&url.Error{Op: "Get", URL: "https://localhost:7700/health", Err: &tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}}}),
+			unreachable: true,
+			tls:         true,
+		},
+		"plain HTTP server": {
+			err:         communicationError(&url.Error{Op: "Get", URL: "https://localhost:7700/health", Err: tls.RecordHeaderError{Msg: "first record does not look like a TLS handshake"}}),
+			unreachable: true,
+			tls:         true,
+		},
+		"invalid api key": {
+			err: apiError(403, CodeInvalidAPIKey),
+		},
+		"other error": {
+			err: x509.HostnameError{},
+			tls: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsUnreachable(testCase.err); got != testCase.unreachable {
+				t.Errorf("IsUnreachable() = %t, expected %t", got, testCase.unreachable)
+			}
+			if got := IsTLS(testCase.err); got != testCase.tls {
+				t.Errorf("IsTLS() = %t, expected %t", got, testCase.tls)
+			}
+		})
+	}
+}
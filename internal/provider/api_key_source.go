@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// apiKeyCommandTimeout bounds the execution of api_key_command, so that a
+// credential helper waiting for input does not stall the whole run.
+const apiKeyCommandTimeout = time.Minute
+
+// resolveAPIKey returns the API key set in the configuration, read from
+// api_key_file or printed by api_key_command, falling back to the matching
+// environment variables. Diagnostics never include the key itself.
+func resolveAPIKey(ctx context.Context, config MeilisearchProviderModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !config.ApiKey.IsNull():
+		return config.ApiKey.ValueString(), diags
+	case !config.ApiKeyFile.IsNull():
+		return readAPIKeyFile(config.ApiKeyFile.ValueString(), path.Root("api_key_file"))
+	case !config.ApiKeyCommand.IsNull():
+		var command []string
+
+		diags.Append(config.ApiKeyCommand.ElementsAs(ctx, &command, false)...)
+		if diags.HasError() {
+			return "", diags
+		}
+
+		return runAPIKeyCommand(ctx, command, path.Root("api_key_command"))
+	}
+
+	if apiKey := os.Getenv("MEILISEARCH_API_KEY"); apiKey != "" {
+		return apiKey, diags
+	}
+
+	if file := os.Getenv("MEILISEARCH_API_KEY_FILE"); file != "" {
+		return readAPIKeyFile(file, path.Root("api_key_file"))
+	}
+
+	if command := strings.Fields(os.Getenv("MEILISEARCH_API_KEY_COMMAND")); len(command) > 0 {
+		return runAPIKeyCommand(ctx, command, path.Root("api_key_command"))
+	}
+
+	return "", diags
+}
+
+// readAPIKeyFile reads the API key from a file, ignoring the surrounding
+// whitespace such as a trailing newline.
+func readAPIKeyFile(file string, attribute path.Path) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		diags.AddAttributeError(
+			attribute,
+			"Unable to Read Meilisearch API Key File",
+			"Could not read the API key file: "+err.Error(),
+		)
+		return "", diags
+	}
+
+	apiKey := strings.TrimSpace(string(content))
+	if apiKey == "" {
+		diags.AddAttributeError(
+			attribute,
+			"Empty Meilisearch API Key File",
+			"The API key file "+file+" is empty.",
+		)
+	}
+
+	return apiKey, diags
+}
+
+// runAPIKeyCommand runs a credential helper and returns its standard output,
+// ignoring the surrounding whitespace. The command is run directly, without a
+// shell.
+func runAPIKeyCommand(ctx context.Context, command []string, attribute path.Path) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(command) == 0 || command[0] == "" {
+		diags.AddAttributeError(
+			attribute,
+			"Invalid Meilisearch API Key Command",
+			"The API key command must contain at least the program to run.",
+		)
+		return "", diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, apiKeyCommandTimeout)
+	defer cancel()
+
+	var stdout bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+
+	// The output is deliberately left out of the diagnostics and logs, since
+	// it may contain the key even when the command fails.
+	if err := cmd.Run(); err != nil {
+		detail := "The API key command " + command[0] + " failed: "
+
+		var exitErr *exec.ExitError
+
+		switch {
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			detail += "it did not complete within " + apiKeyCommandTimeout.String() + "."
+		case errors.As(err, &exitErr):
+			detail += exitErr.ProcessState.String() + ". Run the command manually to see its output."
+		default:
+			detail += err.Error()
+		}
+
+		diags.AddAttributeError(
+			attribute,
+			"Unable to Run Meilisearch API Key Command",
+			detail,
+		)
+		return "", diags
+	}
+
+	apiKey := strings.TrimSpace(stdout.String())
+	if apiKey == "" {
+		diags.AddAttributeError(
+			attribute,
+			"Empty Meilisearch API Key",
+			"The API key command "+command[0]+" did not print any API key on its standard output.",
+		)
+	}
+
+	return apiKey, diags
+}
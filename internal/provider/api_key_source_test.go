@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testAPIKey = "T35T-M45T3R-K3Y"
+
+func testAPIKeyConfig(t *testing.T, apiKey, file string, command []string) MeilisearchProviderModel {
+	config := MeilisearchProviderModel{
+		ApiKey:        types.StringNull(),
+		ApiKeyFile:    types.StringNull(),
+		ApiKeyCommand: types.ListNull(types.StringType),
+	}
+
+	if apiKey != "" {
+		config.ApiKey = types.StringValue(apiKey)
+	}
+
+	if file != "" {
+		config.ApiKeyFile = types.StringValue(file)
+	}
+
+	if command != nil {
+		value, diags := types.ListValueFrom(context.Background(), types.StringType, command)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		config.ApiKeyCommand = value
+	}
+
+	return config
+}
+
+func TestResolveAPIKey(t *testing.T) {
+	for _, variable := range []string{"MEILISEARCH_API_KEY", "MEILISEARCH_API_KEY_FILE", "MEILISEARCH_API_KEY_COMMAND"} {
+		t.Setenv(variable, "")
+	}
+
+	directory := t.TempDir()
+
+	keyFile := filepath.Join(directory, "key")
+	if err := os.WriteFile(keyFile, []byte(testAPIKey+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	emptyFile := filepath.Join(directory, "empty")
+	if err := os.WriteFile(emptyFile, []byte("\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		config          MeilisearchProviderModel
+		env             map[string]string
+		expectedAPIKey  string
+		expectedSummary string
+	}{
+		"api key": {
+			config:         testAPIKeyConfig(t, testAPIKey, "", nil),
+			expectedAPIKey: testAPIKey,
+		},
+		"api key file": {
+			config:         testAPIKeyConfig(t, "", keyFile, nil),
+			expectedAPIKey: testAPIKey,
+		},
+		"api key command": {
+			config:         testAPIKeyConfig(t, "", "", []string{"echo", testAPIKey}),
+			expectedAPIKey: testAPIKey,
+		},
+		"configuration over environment": {
+			config:         testAPIKeyConfig(t, "", keyFile, nil),
+			env:            map[string]string{"MEILISEARCH_API_KEY": "other"},
+			expectedAPIKey: testAPIKey,
+		},
+		"api key environment variable": {
+			config:         testAPIKeyConfig(t, "", "", nil),
+			env:            map[string]string{"MEILISEARCH_API_KEY": testAPIKey, "MEILISEARCH_API_KEY_FILE": emptyFile},
+			expectedAPIKey: testAPIKey,
+		},
+		"api key file environment variable": {
+			config:         testAPIKeyConfig(t, "", "", nil),
+			env:            map[string]string{"MEILISEARCH_API_KEY_FILE": keyFile},
+			expectedAPIKey: testAPIKey,
+		},
+		"api key command environment variable": {
+			config:         testAPIKeyConfig(t, "", "", nil),
+			env:            map[string]string{"MEILISEARCH_API_KEY_COMMAND": "echo " + testAPIKey},
+			expectedAPIKey: testAPIKey,
+		},
+		"no api key": {
+			config: testAPIKeyConfig(t, "", "", nil),
+		},
+		"missing file": {
+			config:          testAPIKeyConfig(t, "", filepath.Join(directory, "missing"), nil),
+			expectedSummary: "Unable to Read Meilisearch API Key File",
+		},
+		"empty file": {
+			config:          testAPIKeyConfig(t, "", emptyFile, nil),
+			expectedSummary: "Empty Meilisearch API Key File",
+		},
+		"failing command": {
+			config:          testAPIKeyConfig(t, "", "", []string{"sh", "-c", "echo " + testAPIKey + "; echo " + testAPIKey + " >&2; exit 3"}),
+			expectedSummary: "Unable to Run Meilisearch API Key Command",
+		},
+		"unknown command": {
+			config:          testAPIKeyConfig(t, "", "", []string{filepath.Join(directory, "missing")}),
+			expectedSummary: "Unable to Run Meilisearch API Key Command",
+		},
+		"silent command": {
+			config:          testAPIKeyConfig(t, "", "", []string{"true"}),
+			expectedSummary: "Empty Meilisearch API Key",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for variable, value := range testCase.env {
+				t.Setenv(variable, value)
+			}
+
+			apiKey, diags := resolveAPIKey(context.Background(), testCase.config)
+
+			if testCase.expectedSummary == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+
+				if apiKey != testCase.expectedAPIKey {
+					t.Errorf("expected API key %q, got %q", testCase.expectedAPIKey, apiKey)
+				}
+				return
+			}
+
+			if !diags.HasError() {
+				t.Fatal("expected an error")
+			}
+
+			if summary := diags[0].Summary(); summary != testCase.expectedSummary {
+				t.Errorf("expected summary %q, got %q", testCase.expectedSummary, summary)
+			}
+
+			if strings.Contains(diags[0].Detail(), testAPIKey) {
+				t.Errorf("diagnostic detail reveals the API key: %s", diags[0].Detail())
+			}
+		})
+	}
+}
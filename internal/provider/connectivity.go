@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-meilisearch/internal/apierror"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// connectivityCheckTimeout bounds the requests sent by verifyConnectivity, so
+// that an unresponsive host fails fast instead of stalling the whole run.
+const connectivityCheckTimeout = 30 * time.Second
+
+// healthStatusAvailable is the status reported by the health endpoint when
+// the server is ready to handle requests.
+const healthStatusAvailable = "available"
+
+// verifyConnectivity verifies that the Meilisearch server at host answers and
+// that the API key is accepted, reporting a diagnostic that names the cause
+// of the failure (unreachable host, TLS failure, missing or non-master key).
+func verifyConnectivity(ctx context.Context, client meilisearch.ServiceManager, host string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, connectivityCheckTimeout)
+	defer cancel()
+
+	health, err := client.HealthWithContext(ctx)
+	if err != nil {
+		switch {
+		case apierror.IsTLS(err):
+			diags.AddAttributeError(
+				path.Root("host"),
+				"Meilisearch TLS Failure",
+				"Could not establish a TLS connection with the Meilisearch server at "+host+". "+
+					"Check that the host scheme matches the server and that its certificate is trusted: "+apierror.Describe(err),
+			)
+		case apierror.IsUnreachable(err):
+			diags.AddAttributeError(
+				path.Root("host"),
+				"Meilisearch Unreachable",
+				"Could not connect to the Meilisearch server at "+host+". "+
+					"Check the host value and that the server is running: "+apierror.Describe(err),
+			)
+		default:
+			diags.AddAttributeError(
+				path.Root("host"),
+				"Meilisearch Unhealthy",
+				"The Meilisearch server at "+host+" did not report a healthy status: "+apierror.Describe(err),
+			)
+		}
+		return diags
+	}
+
+	if health.Status != healthStatusAvailable {
+		diags.AddAttributeError(
+			path.Root("host"),
+			"Meilisearch Unhealthy",
+			"The Meilisearch server at "+host+" reported the status "+health.Status+" instead of "+healthStatusAvailable+".",
+		)
+		return diags
+	}
+
+	// Listing keys requires the master key or a key allowed to manage keys
+	_, err = client.GetKeysWithContext(ctx, &meilisearch.KeysQuery{Limit: 1})
+	if err == nil {
+		return diags
+	}
+
+	if apierror.IsUnauthenticated(err) {
+		diags.AddAttributeError(
+			path.Root("api_key"),
+			"Missing Meilisearch API Key",
+			"The Meilisearch server at "+host+" requires an API key: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	if !apierror.IsUnauthorized(err) {
+		diags.AddError(
+			"Unable to Verify Meilisearch API Key",
+			"Could not check the API key against the Meilisearch server at "+host+": "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	// Meilisearch answers invalid_api_key both for unknown keys and for keys
+	// lacking the action, so a rejected key is only reported as a warning
+	_, err = client.VersionWithContext(ctx)
+	if err != nil && apierror.IsUnauthorized(err) {
+		diags.AddAttributeWarning(
+			path.Root("api_key"),
+			"Unverified Meilisearch API Key",
+			"The Meilisearch server at "+host+" rejected the API key for both listing API keys and reading its version. "+
+				"The key is either invalid or expired, or lacks the keys.get and version actions, "+
+				"in which case resources and data sources requiring other actions than the ones granted to the key will fail: "+
+				apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("api_key"),
+		"Non-Master Meilisearch API Key",
+		"The API key is accepted by the Meilisearch server at "+host+" but cannot list API keys, so it is not the master key. "+
+			"Resources and data sources requiring other actions than the ones granted to the key will fail.",
+	)
+
+	return diags
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+const testMissingAPIKeyBody = `{"message": "The Authorization header is missing. It must use the bearer authorization method.", "code": "missing_authorization_header", "type": "auth", "link": "https://docs.meilisearch.com/errors#missing_authorization_header"}`
+
+const testInvalidAPIKeyBody = `{"message": "The provided API key is invalid.", "code": "invalid_api_key", "type": "auth", "link": "https://docs.meilisearch.com/errors#invalid_api_key"}`
+
+// newConnectivityTestServer starts a server answering the health endpoint
+// successfully and the keys and version endpoints with the given statuses.
+func newConnectivityTestServer(t *testing.T, keysStatus, versionStatus int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		status, body := http.StatusOK, `{}`
+
+		switch r.URL.Path {
+		case "/health":
+			body = `{"status": "available"}`
+		case "/keys":
+			status, body = keysStatus, `{"results": [], "offset": 0, "limit": 1, "total": 0}`
+		case "/version":
+			status, body = versionStatus, `{"commitSha": "abc", "commitDate": "2024-01-01T00:00:00Z", "pkgVersion": "1.13.0"}`
+		}
+
+		switch status {
+		case http.StatusUnauthorized:
+			body = testMissingAPIKeyBody
+		case http.StatusForbidden:
+			body = testInvalidAPIKeyBody
+		}
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestVerifyConnectivity(t *testing.T) {
+	closedServer := httptest.NewServer(http.NotFoundHandler())
+	closedServer.Close()
+
+	tlsServer := httptest.NewUnstartedServer(http.NotFoundHandler())
+	tlsServer.Config.ErrorLog = log.New(io.Discard, "", 0)
+	tlsServer.StartTLS()
+	t.Cleanup(tlsServer.Close)
+
+	testCases := map[string]struct {
+		host            string
+		expectedSummary string
+		expectedError   bool
+	}{
+		"master key": {
+			host: newConnectivityTestServer(t, http.StatusOK, http.StatusOK).URL,
+		},
+		"non-master key": {
+			host:            newConnectivityTestServer(t, http.StatusForbidden, http.StatusOK).URL,
+			expectedSummary: "Non-Master Meilisearch API Key",
+		},
+		// Invalid keys cannot be told apart from keys restricted to other
+		// actions, such as search and documents
+		"invalid or restricted key": {
+			host:            newConnectivityTestServer(t, http.StatusForbidden, http.StatusForbidden).URL,
+			expectedSummary: "Unverified Meilisearch API Key",
+		},
+		"missing key": {
+			host:            newConnectivityTestServer(t, http.StatusUnauthorized, http.StatusUnauthorized).URL,
+			expectedSummary: "Missing Meilisearch API Key",
+			expectedError:   true,
+		},
+		"unreachable": {
+			host:            closedServer.URL,
+			expectedSummary: "Meilisearch Unreachable",
+			expectedError:   true,
+		},
+		"untrusted certificate": {
+			host:            tlsServer.URL,
+			expectedSummary: "Meilisearch TLS Failure",
+			expectedError:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := meilisearch.New(testCase.host, meilisearch.WithAPIKey("secret-key"))
+
+			diags := verifyConnectivity(context.Background(), client, testCase.host)
+
+			if testCase.expectedSummary == "" {
+				if len(diags) > 0 {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %v", diags)
+			}
+
+			if summary := diags[0].Summary(); summary != testCase.expectedSummary {
+				t.Errorf("expected summary %q, got %q", testCase.expectedSummary, summary)
+			}
+
+			if isError := diags[0].Severity() == diag.SeverityError; isError != testCase.expectedError {
+				t.Errorf("expected error severity to be %t", testCase.expectedError)
+			}
+
+			if strings.Contains(diags[0].Detail(), "secret-key") {
+				t.Errorf("diagnostic detail reveals the API key: %s", diags[0].Detail())
+			}
+		})
+	}
+}
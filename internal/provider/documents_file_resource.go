@@ -0,0 +1,667 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-meilisearch/internal/apierror"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+const (
+	documentsFormatJSON   = "json"
+	documentsFormatNDJSON = "ndjson"
+	documentsFormatCSV    = "csv"
+
+	// documentIDsPrivateKey is the private state key holding the primary key
+	// values of the documents loaded from the file.
+	documentIDsPrivateKey = "document_ids"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &documentsFileResource{}
+	_ resource.ResourceWithConfigure      = &documentsFileResource{}
+	_ resource.ResourceWithModifyPlan     = &documentsFileResource{}
+	_ resource.ResourceWithValidateConfig = &documentsFileResource{}
+)
+
+// NewDocumentsFileResource is a helper function to simplify the provider implementation.
+func NewDocumentsFileResource() resource.Resource {
+	return &documentsFileResource{}
+}
+
+// documentsFileResource is the resource implementation.
+type documentsFileResource struct {
+	client meilisearch.ServiceManager
+}
+
+type documentsFileResourceModel struct {
+	IndexUID      types.String   `tfsdk:"index_uid"`
+	Path          types.String   `tfsdk:"path"`
+	Format        types.String   `tfsdk:"format"`
+	PrimaryKey    types.String   `tfsdk:"primary_key"`
+	BatchSize     types.Int64    `tfsdk:"batch_size"`
+	CsvDelimiter  types.String   `tfsdk:"csv_delimiter"`
+	ContentSHA256 types.String   `tfsdk:"content_sha256"`
+	ID            types.String   `tfsdk:"id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *documentsFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_documents_file"
+}
+
+// Schema defines the schema for the resource.
+func (r *documentsFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Loads documents from a JSON, NDJSON or CSV file into a Meilisearch index. " +
+			"Documents are sent again whenever the content of the file changes, and documents removed from the file are deleted from the index.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the file containing the documents.",
+				Required:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Format of the file, one of `json` (array of objects), `ndjson` or `csv`. " +
+					"Inferred from the extension of the file when not set (`.json`, `.ndjson`, `.jsonl` or `.csv`).",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(documentsFormatJSON, documentsFormatNDJSON, documentsFormatCSV),
+				},
+			},
+			"primary_key": schema.StringAttribute{
+				Description: "Primary key of the documents, used to identify them in the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"batch_size": schema.Int64Attribute{
+				Description: "Maximum number of documents sent in a single request. Defaults to `1000`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1000),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"csv_delimiter": schema.StringAttribute{
+				Description: "Single ASCII character separating the values of a CSV file. Defaults to `,`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(","),
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1),
+				},
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "SHA-256 checksum of the content of the file, used to trigger updates.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *documentsFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+}
+
+// ValidateConfig checks that the format of the file is known.
+func (r *documentsFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config documentsFileResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Format.IsNull() || config.Path.IsUnknown() {
+		return
+	}
+
+	if inferDocumentsFormat(config.Path.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("format"),
+			"Missing Documents Format",
+			fmt.Sprintf("The format of %q cannot be inferred from its extension, please set the format attribute.", config.Path.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan sets the format and checksum of the file, so that any change of
+// its content results in an update.
+func (r *documentsFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan documentsFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Path.IsUnknown() {
+		return
+	}
+
+	if plan.Format.IsUnknown() || plan.Format.IsNull() {
+		plan.Format = types.StringValue(inferDocumentsFormat(plan.Path.ValueString()))
+	}
+
+	content, err := os.ReadFile(plan.Path.ValueString())
+	if err != nil {
+		// The file may be produced during the apply
+		tflog.Debug(ctx, "Documents file not readable during plan", map[string]any{"error": err.Error()})
+		plan.ContentSHA256 = types.StringUnknown()
+	} else {
+		plan.ContentSHA256 = types.StringValue(contentSHA256(content))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *documentsFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan documentsFileResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	content, ids, diags := plan.load()
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.saveDocuments(ctx, &plan, content)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setDocumentIDs(ctx, resp.Private, ids)...)
+
+	plan.ID = types.StringValue("placeholder")
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *documentsFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state documentsFileResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Documents are gone with their index
+	_, err := r.client.GetIndexWithContext(ctx, state.IndexUID.ValueString())
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Meilisearch Index",
+			"Could not read Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *documentsFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan documentsFileResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	previousIDs, diags := getDocumentIDs(ctx, req.Private)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, ids, diags := plan.load()
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the documents removed from the file
+	kept := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		kept[id] = true
+	}
+
+	var removedIDs []string
+
+	for _, id := range previousIDs {
+		if !kept[id] {
+			removedIDs = append(removedIDs, id)
+		}
+	}
+
+	resp.Diagnostics.Append(deleteDocuments(ctx, r.client, plan.IndexUID.ValueString(), removedIDs, int(plan.BatchSize.ValueInt64()))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.saveDocuments(ctx, &plan, content)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setDocumentIDs(ctx, resp.Private, ids)...)
+
+	plan.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *documentsFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state documentsFileResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	ids, diags := getDocumentIDs(ctx, req.Private)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Documents are already gone with their index
+	_, err := r.client.GetIndexWithContext(ctx, state.IndexUID.ValueString())
+	if apierror.IsNotFound(err) {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteDocuments(ctx, r.client, state.IndexUID.ValueString(), ids, int(state.BatchSize.ValueInt64()))...)
+}
+
+// saveDocuments sends the content of the file in batches, using the endpoint
+// matching its format, and waits for every resulting task.
+func (r *documentsFileResource) saveDocuments(ctx context.Context, model *documentsFileResourceModel, content []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	index := r.client.Index(model.IndexUID.ValueString())
+	batchSize := int(model.BatchSize.ValueInt64())
+	primaryKey := model.PrimaryKey.ValueString()
+
+	var tasks []meilisearch.TaskInfo
+	var err error
+
+	switch model.Format.ValueString() {
+	case documentsFormatNDJSON:
+		tasks, err = index.AddDocumentsNdjsonInBatchesWithContext(ctx, content, batchSize, model.PrimaryKey.ValueStringPointer())
+	case documentsFormatCSV:
+		tasks, err = index.AddDocumentsCsvInBatchesWithContext(ctx, content, batchSize, &meilisearch.CsvDocumentsQuery{
+			PrimaryKey:   primaryKey,
+			CsvDelimiter: model.CsvDelimiter.ValueString(),
+		})
+	default:
+		var documents []json.RawMessage
+
+		if err := json.Unmarshal(content, &documents); err != nil {
+			diags.AddAttributeError(
+				path.Root("path"),
+				"Invalid Documents File",
+				"The file must contain a JSON array of objects: "+err.Error(),
+			)
+			return diags
+		}
+
+		tasks, err = index.AddDocumentsInBatchesWithContext(ctx, documents, batchSize, model.PrimaryKey.ValueStringPointer())
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Error Saving Meilisearch Documents",
+			"Could not save documents from "+model.Path.ValueString()+", unexpected error: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(waitForTasks(ctx, r.client, tasks)...)
+
+	return diags
+}
+
+// load reads the file, ensures it did not change since the plan and returns
+// its content along with the primary key value of each document.
+func (m *documentsFileResourceModel) load() ([]byte, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	content, err := os.ReadFile(m.Path.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("path"),
+			"Error Reading Documents File",
+			"Could not read "+m.Path.ValueString()+": "+err.Error(),
+		)
+		return nil, nil, diags
+	}
+
+	checksum := contentSHA256(content)
+
+	if !m.ContentSHA256.IsUnknown() && m.ContentSHA256.ValueString() != checksum {
+		diags.AddAttributeError(
+			path.Root("path"),
+			"Documents File Changed",
+			"The content of "+m.Path.ValueString()+" changed since the plan was created. Please run the plan again.",
+		)
+		return nil, nil, diags
+	}
+
+	m.ContentSHA256 = types.StringValue(checksum)
+
+	if m.Format.IsUnknown() || m.Format.IsNull() {
+		m.Format = types.StringValue(inferDocumentsFormat(m.Path.ValueString()))
+	}
+
+	var documents []document
+
+	switch m.Format.ValueString() {
+	case documentsFormatNDJSON:
+		documents, err = decodeNDJSONDocuments(content)
+	case documentsFormatCSV:
+		documents, err = decodeCSVDocuments(content, m.CsvDelimiter.ValueString(), m.PrimaryKey.ValueString())
+	default:
+		documents, err = decodeJSONDocuments(content)
+	}
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("path"),
+			"Invalid Documents File",
+			"Could not decode "+m.Path.ValueString()+" as "+m.Format.ValueString()+": "+err.Error(),
+		)
+		return nil, nil, diags
+	}
+
+	ids, diags := documentIDs(documents, m.PrimaryKey.ValueString(), func(int) path.Path {
+		return path.Root("path")
+	})
+
+	return content, ids, diags
+}
+
+// inferDocumentsFormat returns the format matching the extension of a file,
+// or an empty string when it is unknown.
+func inferDocumentsFormat(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".json":
+		return documentsFormatJSON
+	case ".ndjson", ".jsonl":
+		return documentsFormatNDJSON
+	case ".csv":
+		return documentsFormatCSV
+	default:
+		return ""
+	}
+}
+
+func contentSHA256(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func decodeJSONDocuments(content []byte) ([]document, error) {
+	var raws []json.RawMessage
+
+	if err := json.Unmarshal(content, &raws); err != nil {
+		return nil, err
+	}
+
+	documents := make([]document, 0, len(raws))
+
+	for i, raw := range raws {
+		doc, err := decodeDocument(raw)
+		if err != nil {
+			return nil, fmt.Errorf("document at index %d: %w", i, err)
+		}
+
+		documents = append(documents, doc)
+	}
+
+	return documents, nil
+}
+
+func decodeNDJSONDocuments(content []byte) ([]document, error) {
+	var documents []document
+
+	reader := bufio.NewReader(bytes.NewReader(content))
+
+	for line := 1; ; line++ {
+		raw, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+
+		if len(bytes.TrimSpace(raw)) > 0 {
+			doc, decodeErr := decodeDocument(raw)
+			if decodeErr != nil {
+				return nil, fmt.Errorf("line %d: %w", line, decodeErr)
+			}
+
+			documents = append(documents, doc)
+		}
+
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+	}
+}
+
+// decodeCSVDocuments only decodes the primary key column of a CSV file, its
+// header being allowed to carry a type annotation such as `id:number`.
+func decodeCSVDocuments(content []byte, delimiter string, primaryKey string) ([]document, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comma, _ = utf8.DecodeRuneInString(delimiter)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read header: %w", err)
+	}
+
+	column := -1
+
+	for i, name := range header {
+		name, _, _ = strings.Cut(name, ":")
+		if name == primaryKey {
+			column = i
+			break
+		}
+	}
+
+	if column == -1 {
+		return nil, fmt.Errorf("no %q column in header", primaryKey)
+	}
+
+	var documents []document
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		documents = append(documents, document{primaryKey: record[column]})
+	}
+}
+
+func getDocumentIDs(ctx context.Context, private privateState) ([]string, diag.Diagnostics) {
+	var ids []string
+
+	raw, diags := private.GetKey(ctx, documentIDsPrivateKey)
+	if diags.HasError() || raw == nil {
+		return ids, diags
+	}
+
+	if err := json.Unmarshal(raw, &ids); err != nil {
+		diags.AddError(
+			"Error Reading Private State",
+			"Could not decode the identifiers of the loaded documents: "+err.Error(),
+		)
+	}
+
+	return ids, diags
+}
+
+func setDocumentIDs(ctx context.Context, private privateState, ids []string) diag.Diagnostics {
+	if ids == nil {
+		ids = []string{}
+	}
+
+	raw, err := json.Marshal(ids)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Error Writing Private State", err.Error())}
+	}
+
+	return private.SetKey(ctx, documentIDsPrivateKey, raw)
+}
+
+// privateState is implemented by the private state of requests and responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
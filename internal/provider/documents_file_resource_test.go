@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDocumentsFileResource(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "movies.ndjson")
+
+	writeFile := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	config := providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "documents-file-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_documents_file" "test" {
+	index_uid = meilisearch_index.test.uid
+	primary_key = "id"
+	path = "` + filepath.ToSlash(file) + `"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: writeFile(`{"id": 1, "title": "Carol"}
+{"id": 2, "title": "Wonder Woman"}
+`),
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_documents_file.test", "format", "ndjson"),
+					resource.TestCheckResourceAttr("meilisearch_documents_file.test", "batch_size", "1000"),
+					resource.TestCheckResourceAttrSet("meilisearch_documents_file.test", "content_sha256"),
+				),
+			},
+			// Update and Read testing
+			{
+				PreConfig: writeFile(`{"id": 1, "title": "Carol"}
+{"id": 3, "title": "Life of Pi"}
+`),
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("meilisearch_documents_file.test", "content_sha256"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestDecodeDocumentsFile(t *testing.T) {
+	ndjson, err := decodeNDJSONDocuments([]byte("{\"id\": 1}\n\n{\"id\": \"two\"}"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ids, diags := documentIDs(ndjson, "id", nil)
+	if diags.HasError() || !reflect.DeepEqual(ids, []string{"1", "two"}) {
+		t.Errorf("unexpected NDJSON identifiers %v: %v", ids, diags)
+	}
+
+	csv, err := decodeCSVDocuments([]byte("title;id:number\nCarol;1\nWonder Woman;2\n"), ";", "id")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ids, diags = documentIDs(csv, "id", nil)
+	if diags.HasError() || !reflect.DeepEqual(ids, []string{"1", "2"}) {
+		t.Errorf("unexpected CSV identifiers %v: %v", ids, diags)
+	}
+
+	if _, err := decodeCSVDocuments([]byte("title\nCarol\n"), ",", "id"); err == nil {
+		t.Error("expected an error for a missing primary key column")
+	}
+
+	if _, err := decodeJSONDocuments([]byte(`{"id": 1}`)); err == nil {
+		t.Error("expected an error for a JSON object instead of an array")
+	}
+}
@@ -0,0 +1,837 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"slices"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &documentsResource{}
+	_ resource.ResourceWithConfigure        = &documentsResource{}
+	_ resource.ResourceWithConfigValidators = &documentsResource{}
+	_ resource.ResourceWithValidateConfig   = &documentsResource{}
+)
+
+// NewDocumentsResource is a helper function to simplify the provider implementation.
+func NewDocumentsResource() resource.Resource {
+	return &documentsResource{}
+}
+
+// documentsResource is the resource implementation.
+type documentsResource struct {
+	client       meilisearch.ServiceManager
+	providerData *providerData
+}
+
+type documentsResourceModel struct {
+	IndexUID      types.String   `tfsdk:"index_uid"`
+	PrimaryKey    types.String   `tfsdk:"primary_key"`
+	Documents     types.List     `tfsdk:"documents"`
+	DocumentsJSON types.String   `tfsdk:"documents_json"`
+	PartialUpdate types.Bool     `tfsdk:"partial_update"`
+	BatchSize     types.Int64    `tfsdk:"batch_size"`
+	ID            types.String   `tfsdk:"id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// document is a Meilisearch document decoded from JSON, numbers being kept
+// as json.Number to avoid any loss of precision.
+type document map[string]any
+
+// documentsByIDMinVersion is the first Meilisearch version fetching several
+// documents by primary key in a single request.
+var documentsByIDMinVersion = serverVersion{major: 1, minor: 14}
+
+// Metadata returns the resource type name.
+func (r *documentsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_documents"
+}
+
+// Schema defines the schema for the resource.
+func (r *documentsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of documents of a Meilisearch index, identified by their primary key. " +
+			"Documents of the index that are not declared in the resource are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary_key": schema.StringAttribute{
+				Description: "Primary key of the documents, used to identify them in the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"documents": schema.ListAttribute{
+				Description: "Documents, each one being a JSON object (for instance built with `jsonencode`). Conflicts with `documents_json`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"documents_json": schema.StringAttribute{
+				Description: "Documents as a JSON array of objects (for instance built with `jsonencode`). Conflicts with `documents`.",
+				Optional:    true,
+			},
+			"partial_update": schema.BoolAttribute{
+				Description: "Whether documents are partially updated, keeping the fields that are not declared in the configuration " +
+					"(see [official documentation](https://www.meilisearch.com/docs/reference/api/documents#add-or-update-documents)). " +
+					"By default, documents are fully replaced. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"batch_size": schema.Int64Attribute{
+				Description: "Maximum number of documents sent or read in a single request, documents being read one by one before Meilisearch 1.14. Defaults to `1000`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1000),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *documentsResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+	r.providerData = data
+}
+
+func (r *documentsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("documents"),
+			path.MatchRoot("documents_json"),
+		),
+	}
+}
+
+// ValidateConfig checks that every document is a JSON object with a unique
+// primary key value.
+func (r *documentsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config documentsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.PrimaryKey.IsUnknown() || config.Documents.IsUnknown() || config.DocumentsJSON.IsUnknown() {
+		return
+	}
+
+	if !config.Documents.IsNull() {
+		for _, element := range config.Documents.Elements() {
+			if element.IsUnknown() {
+				return
+			}
+		}
+	}
+
+	documents, diags := config.documents(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags = documentIDs(documents, config.PrimaryKey.ValueString(), config.documentPath)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *documentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan documentsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	documents, diags := plan.documents(ctx)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.saveDocuments(ctx, &plan, documents)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue("placeholder")
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *documentsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state documentsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	documents, diags := state.documents(ctx)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, diags := documentIDs(documents, state.PrimaryKey.ValueString(), state.documentPath)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the managed fields matter when documents are partially updated
+	var fields []string
+	if state.PartialUpdate.ValueBool() {
+		fields = documentFields(documents)
+	}
+
+	// Fetch every managed document by its primary key to detect drift
+	fetched, err := r.fetchDocuments(ctx, state.IndexUID.ValueString(), state.PrimaryKey.ValueString(), ids, fields, int(state.BatchSize.ValueInt64()))
+	if err != nil {
+		if apierror.HasCode(err, apierror.CodeIndexNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Meilisearch Documents",
+			"Could not read the documents of Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+		)
+		return
+	}
+
+	var refreshed []document
+	drift := false
+
+	for i, id := range ids {
+		current, ok := fetched[id]
+		if !ok {
+			drift = true
+			continue
+		}
+
+		// Fields of the other documents are not managed by this one
+		if fields != nil {
+			for field := range current {
+				if _, managed := documents[i][field]; !managed {
+					delete(current, field)
+				}
+			}
+		}
+
+		if !equalDocuments(current, documents[i]) {
+			drift = true
+		}
+
+		refreshed = append(refreshed, current)
+	}
+
+	// Keep the configured representation of the documents unless they changed
+	if drift {
+		resp.Diagnostics.Append(state.setDocuments(ctx, documents, refreshed)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *documentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state documentsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	planned, diags := plan.documents(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	plannedIDs, diags := documentIDs(planned, plan.PrimaryKey.ValueString(), plan.documentPath)
+	resp.Diagnostics.Append(diags...)
+
+	current, diags := state.documents(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	currentIDs, diags := documentIDs(current, state.PrimaryKey.ValueString(), state.documentPath)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	currentByID := make(map[string]document, len(current))
+	for i, id := range currentIDs {
+		currentByID[id] = current[i]
+	}
+
+	// Only send documents that were added or changed
+	var changed []document
+
+	for i, id := range plannedIDs {
+		if previous, ok := currentByID[id]; !ok || !equalDocuments(previous, planned[i]) {
+			changed = append(changed, planned[i])
+		}
+
+		delete(currentByID, id)
+	}
+
+	// Documents left were removed from the configuration
+	var removedIDs []string
+
+	for _, id := range currentIDs {
+		if _, ok := currentByID[id]; ok {
+			removedIDs = append(removedIDs, id)
+		}
+	}
+
+	resp.Diagnostics.Append(deleteDocuments(ctx, r.client, plan.IndexUID.ValueString(), removedIDs, int(plan.BatchSize.ValueInt64()))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.saveDocuments(ctx, &plan, changed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *documentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state documentsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	documents, diags := state.documents(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	ids, diags := documentIDs(documents, state.PrimaryKey.ValueString(), state.documentPath)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Documents are already gone with their index
+	_, err := r.client.GetIndexWithContext(ctx, state.IndexUID.ValueString())
+	if apierror.IsNotFound(err) {
+		return
+	}
+
+	resp.Diagnostics.Append(deleteDocuments(ctx, r.client, state.IndexUID.ValueString(), ids, int(state.BatchSize.ValueInt64()))...)
+}
+
+// saveDocuments adds or updates documents in batches and waits for every
+// resulting task.
+func (r *documentsResource) saveDocuments(ctx context.Context, model *documentsResourceModel, documents []document) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(documents) == 0 {
+		return diags
+	}
+
+	index := r.client.Index(model.IndexUID.ValueString())
+	batchSize := int(model.BatchSize.ValueInt64())
+	primaryKey := model.PrimaryKey.ValueStringPointer()
+
+	var tasks []meilisearch.TaskInfo
+	var err error
+
+	if model.PartialUpdate.ValueBool() {
+		tasks, err = index.UpdateDocumentsInBatchesWithContext(ctx, documents, batchSize, primaryKey)
+	} else {
+		tasks, err = index.AddDocumentsInBatchesWithContext(ctx, documents, batchSize, primaryKey)
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Error Saving Meilisearch Documents",
+			"Could not save documents, unexpected error: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(waitForTasks(ctx, r.client, tasks)...)
+
+	return diags
+}
+
+// fetchDocuments returns, by primary key, the documents of the index having
+// one of the given primary keys, restricted to the given fields if any.
+// Documents are fetched in batches on servers supporting it, one by one
+// otherwise.
+func (r *documentsResource) fetchDocuments(ctx context.Context, indexUID, primaryKey string, ids, fields []string, batchSize int) (map[string]document, error) {
+	index := r.client.Index(indexUID)
+	fetched := make(map[string]document, len(ids))
+
+	if !r.providerData.supports(documentsByIDMinVersion) {
+		var request *meilisearch.DocumentQuery
+		if fields != nil {
+			request = &meilisearch.DocumentQuery{Fields: fields}
+		}
+
+		for _, id := range ids {
+			var raw json.RawMessage
+
+			err := index.GetDocumentWithContext(ctx, id, request, &raw)
+			if apierror.HasCode(err, apierror.CodeDocumentNotFound) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			doc, err := decodeDocument(raw)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode document %s: %w", id, err)
+			}
+
+			fetched[id] = doc
+		}
+
+		return fetched, nil
+	}
+
+	for start := 0; start < len(ids); start += batchSize {
+		end := min(start+batchSize, len(ids))
+
+		var result meilisearch.DocumentsResult
+
+		err := index.GetDocumentsWithContext(ctx, &meilisearch.DocumentsQuery{
+			Ids:    ids[start:end],
+			Fields: fields,
+			Limit:  int64(end - start),
+		}, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hit := range result.Results {
+			raw, err := json.Marshal(hit)
+			if err != nil {
+				return nil, err
+			}
+
+			doc, err := decodeDocument(raw)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode document: %w", err)
+			}
+
+			if id, ok := documentID(doc, primaryKey); ok {
+				fetched[id] = doc
+			}
+		}
+	}
+
+	return fetched, nil
+}
+
+// deleteDocuments deletes documents by primary key, in batches, and waits
+// for every resulting task.
+func deleteDocuments(ctx context.Context, client meilisearch.ServiceManager, indexUID string, ids []string, batchSize int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	index := client.Index(indexUID)
+
+	for start := 0; start < len(ids); start += batchSize {
+		end := min(start+batchSize, len(ids))
+
+		task, err := index.DeleteDocumentsWithContext(ctx, ids[start:end])
+		if err != nil {
+			diags.AddError(
+				"Error Deleting Meilisearch Documents",
+				"Could not delete documents, unexpected error: "+apierror.Describe(err),
+			)
+			return diags
+		}
+
+		diags.Append(waitForTask(ctx, client, task)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// documents decodes the documents of the model, whichever attribute is used.
+func (m *documentsResourceModel) documents(ctx context.Context) ([]document, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var documents []document
+
+	if !m.DocumentsJSON.IsNull() {
+		var raws []json.RawMessage
+
+		if err := json.Unmarshal([]byte(m.DocumentsJSON.ValueString()), &raws); err != nil {
+			diags.AddAttributeError(
+				path.Root("documents_json"),
+				"Invalid Documents",
+				"documents_json must be a JSON array of objects: "+err.Error(),
+			)
+			return nil, diags
+		}
+
+		for i, raw := range raws {
+			doc, err := decodeDocument(raw)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("documents_json"),
+					"Invalid Document",
+					fmt.Sprintf("Document at index %d must be a JSON object: %s", i, err.Error()),
+				)
+				continue
+			}
+
+			documents = append(documents, doc)
+		}
+
+		return documents, diags
+	}
+
+	var raws []string
+
+	diags.Append(m.Documents.ElementsAs(ctx, &raws, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for i, raw := range raws {
+		doc, err := decodeDocument([]byte(raw))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("documents").AtListIndex(i),
+				"Invalid Document",
+				"Document must be a JSON object: "+err.Error(),
+			)
+			continue
+		}
+
+		documents = append(documents, doc)
+	}
+
+	return documents, diags
+}
+
+// setDocuments replaces the documents of the model by their refreshed value,
+// keeping the configured JSON of the documents that did not change.
+func (m *documentsResourceModel) setDocuments(ctx context.Context, previous, refreshed []document) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !m.DocumentsJSON.IsNull() {
+		encoded, err := json.Marshal(refreshed)
+		if err != nil {
+			diags.AddError("Error Encoding Documents", err.Error())
+			return diags
+		}
+
+		if refreshed == nil {
+			encoded = []byte("[]")
+		}
+
+		m.DocumentsJSON = types.StringValue(string(encoded))
+
+		return diags
+	}
+
+	var raws []string
+
+	diags.Append(m.Documents.ElementsAs(ctx, &raws, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	values := []string{}
+
+	for _, doc := range refreshed {
+		raw := ""
+
+		for i, candidate := range previous {
+			if equalDocuments(candidate, doc) {
+				raw = raws[i]
+				break
+			}
+		}
+
+		if raw == "" {
+			encoded, err := json.Marshal(doc)
+			if err != nil {
+				diags.AddError("Error Encoding Document", err.Error())
+				return diags
+			}
+
+			raw = string(encoded)
+		}
+
+		values = append(values, raw)
+	}
+
+	var d diag.Diagnostics
+	m.Documents, d = types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+
+	return diags
+}
+
+// documentPath returns the path of the attribute holding the i-th document.
+func (m *documentsResourceModel) documentPath(i int) path.Path {
+	if !m.DocumentsJSON.IsNull() {
+		return path.Root("documents_json")
+	}
+
+	return path.Root("documents").AtListIndex(i)
+}
+
+// decodeDocument decodes a single JSON object.
+func decodeDocument(raw []byte) (document, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var doc document
+
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	if doc == nil {
+		return nil, fmt.Errorf("unexpected null value")
+	}
+
+	return doc, nil
+}
+
+// documentIDs returns the primary key value of each document, ensuring they
+// are all set and unique.
+func documentIDs(documents []document, primaryKey string, pathOf func(int) path.Path) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ids := make([]string, 0, len(documents))
+	seen := make(map[string]bool, len(documents))
+
+	for i, doc := range documents {
+		id, ok := documentID(doc, primaryKey)
+		if !ok {
+			diags.AddAttributeError(
+				pathOf(i),
+				"Invalid Document Primary Key",
+				fmt.Sprintf("Document at index %d must have a string or integer %q field.", i, primaryKey),
+			)
+			continue
+		}
+
+		if seen[id] {
+			diags.AddAttributeError(
+				pathOf(i),
+				"Duplicate Document",
+				fmt.Sprintf("Document at index %d has the same %q value as a previous document: %s", i, primaryKey, id),
+			)
+			continue
+		}
+
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	return ids, diags
+}
+
+// documentID returns the primary key value of a document, if it is a string
+// or a number.
+func documentID(doc document, primaryKey string) (string, bool) {
+	switch value := doc[primaryKey].(type) {
+	case string:
+		return value, true
+	case json.Number:
+		return value.String(), true
+	}
+
+	return "", false
+}
+
+// documentFields returns the fields set by any of the documents, sorted.
+func documentFields(documents []document) []string {
+	fields := []string{}
+
+	for _, doc := range documents {
+		for field := range doc {
+			if !slices.Contains(fields, field) {
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	slices.Sort(fields)
+
+	return fields
+}
+
+// equalDocuments reports whether two documents hold the same values, numbers
+// being compared by value so that 1 and 1.0 are equal.
+func equalDocuments(a, b document) bool {
+	return reflect.DeepEqual(normalizeJSONNumbers(map[string]any(a)), normalizeJSONNumbers(map[string]any(b)))
+}
+
+// exactNumber is the exact value of a JSON number, as a reduced fraction.
+type exactNumber string
+
+// normalizeJSONNumbers replaces the numbers of a decoded JSON value by their
+// exact value, leaving numbers that cannot be parsed as they are.
+func normalizeJSONNumbers(value any) any {
+	switch value := value.(type) {
+	case json.Number:
+		if number, ok := new(big.Rat).SetString(value.String()); ok {
+			return exactNumber(number.RatString())
+		}
+
+		return value
+	case map[string]any:
+		normalized := make(map[string]any, len(value))
+		for key, item := range value {
+			normalized[key] = normalizeJSONNumbers(item)
+		}
+
+		return normalized
+	case []any:
+		normalized := make([]any, len(value))
+		for i, item := range value {
+			normalized[i] = normalizeJSONNumbers(item)
+		}
+
+		return normalized
+	}
+
+	return value
+}
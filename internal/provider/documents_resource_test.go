@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+func TestAccDocumentsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "documents-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_documents" "test" {
+	index_uid = meilisearch_index.test.uid
+	primary_key = "id"
+	documents = [
+		jsonencode({ id = 1, title = "Carol" }),
+		jsonencode({ id = "two", title = "Wonder Woman" }),
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_documents.test", "index_uid", "documents-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_documents.test", "documents.#", "2"),
+					resource.TestCheckResourceAttr("meilisearch_documents.test", "partial_update", "false"),
+					resource.TestCheckResourceAttr("meilisearch_documents.test", "batch_size", "1000"),
+					resource.TestCheckResourceAttr("meilisearch_documents.test", "id", "placeholder"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "documents-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_documents" "test" {
+	index_uid = meilisearch_index.test.uid
+	primary_key = "id"
+	batch_size = 1
+	documents_json = jsonencode([
+		{ id = 1, title = "Carol", genres = ["Romance"] },
+		{ id = 3, title = "Life of Pi" },
+	])
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_documents.test", "batch_size", "1"),
+					resource.TestCheckNoResourceAttr("meilisearch_documents.test", "documents.#"),
+					resource.TestCheckResourceAttrSet("meilisearch_documents.test", "documents_json"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestEqualDocuments(t *testing.T) {
+	testCases := map[string]struct {
+		a, b  string
+		equal bool
+	}{
+		"same document":     {a: `{"id": 1, "title": "Carol"}`, b: `{"title": "Carol", "id": 1}`, equal: true},
+		"decimal integer":   {a: `{"id": 1, "rating": 4}`, b: `{"id": 1, "rating": 4.0}`, equal: true},
+		"exponent":          {a: `{"id": 1, "views": 1000}`, b: `{"id": 1, "views": 1e3}`, equal: true},
+		"nested numbers":    {a: `{"id": 1, "prices": [{"eur": 10}]}`, b: `{"id": 1, "prices": [{"eur": 10.00}]}`, equal: true},
+		"large integers":    {a: `{"id": 9007199254740993}`, b: `{"id": 9007199254740992}`, equal: false},
+		"different numbers": {a: `{"id": 1, "rating": 4}`, b: `{"id": 1, "rating": 4.5}`, equal: false},
+		"number and string": {a: `{"id": 1}`, b: `{"id": "1"}`, equal: false},
+		"missing field":     {a: `{"id": 1, "title": "Carol"}`, b: `{"id": 1}`, equal: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			a, err := decodeDocument([]byte(testCase.a))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b, err := decodeDocument([]byte(testCase.b))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if equal := equalDocuments(a, b); equal != testCase.equal {
+				t.Errorf("expected equal to be %t, got %t", testCase.equal, equal)
+			}
+		})
+	}
+}
+
+func TestFetchDocuments(t *testing.T) {
+	stored := map[string]string{
+		"1":   `{"id": 1, "title": "Carol", "genres": ["Romance"]}`,
+		"two": `{"id": "two", "title": "Wonder Woman", "genres": ["Action"]}`,
+	}
+
+	testCases := map[string]struct {
+		version          string
+		expectedRequests int32
+	}{
+		"batches":    {version: "1.14.0", expectedRequests: 2},
+		"one by one": {version: "1.13.3", expectedRequests: 3},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+
+				if r.Method == http.MethodPost && r.URL.Path == "/indexes/movies/documents/fetch" {
+					var query meilisearch.DocumentsQuery
+					if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+						t.Errorf("unexpected body: %s", err)
+					}
+
+					if len(query.Fields) != 0 {
+						t.Errorf("unexpected fields %v", query.Fields)
+					}
+
+					var results []string
+					for _, id := range query.Ids {
+						if doc, ok := stored[id]; ok {
+							results = append(results, doc)
+						}
+					}
+
+					_, _ = w.Write([]byte(`{"results": [` + strings.Join(results, ",") + `], "limit": 2, "offset": 0, "total": 2}`))
+					return
+				}
+
+				if doc, ok := stored[strings.TrimPrefix(r.URL.Path, "/indexes/movies/documents/")]; ok && r.Method == http.MethodGet {
+					_, _ = w.Write([]byte(doc))
+					return
+				}
+
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"message": "Document not found.", "code": "document_not_found", "type": "invalid_request", "link": ""}`))
+			}))
+			t.Cleanup(server.Close)
+
+			client := meilisearch.New(server.URL, meilisearch.WithAPIKey("key"), meilisearch.DisableRetries())
+			version, err := parseServerVersion(testCase.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := &documentsResource{client: client, providerData: &providerData{client: client, version: version, rawVersion: testCase.version}}
+
+			fetched, err := r.fetchDocuments(context.Background(), "movies", "id", []string{"1", "two", "3"}, nil, 2)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(fetched) != 2 {
+				t.Fatalf("expected 2 documents, got %v", fetched)
+			}
+
+			if title := fetched["two"]["title"]; title != "Wonder Woman" {
+				t.Errorf("unexpected title %v", title)
+			}
+
+			// Numbers are kept exact
+			if id, ok := fetched["1"]["id"].(json.Number); !ok || id.String() != "1" {
+				t.Errorf("unexpected id %v", fetched["1"]["id"])
+			}
+
+			if got := requests.Load(); got != testCase.expectedRequests {
+				t.Errorf("expected %d requests, got %d", testCase.expectedRequests, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// healthStatusUnavailable is the status reported by the data source when the
+// server could not be reached or did not answer successfully.
+const healthStatusUnavailable = "unavailable"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &healthDataSource{}
+	_ datasource.DataSourceWithConfigure = &healthDataSource{}
+)
+
+func NewHealthDataSource() datasource.DataSource {
+	return &healthDataSource{}
+}
+
+// healthDataSource defines the data source implementation.
+type healthDataSource struct {
+	client meilisearch.ServiceManager
+}
+
+type healthDataSourceModel struct {
+	Status    types.String `tfsdk:"status"`
+	Available types.Bool   `tfsdk:"available"`
+	ID        types.String `tfsdk:"id"`
+}
+
+func (d *healthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_health"
+}
+
+func (d *healthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the health of the Meilisearch server. An unreachable or failing server is reported as unavailable " +
+			"with a warning instead of an error, so that the data source can be used in `check` blocks and preconditions.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Description: "Status reported by the server (`available`), or `unavailable` when the server could not be reached.",
+				Computed:    true,
+			},
+			"available": schema.BoolAttribute{
+				Description: "Whether the server is available.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *healthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state healthDataSourceModel
+
+	state.Status = types.StringValue(healthStatusUnavailable)
+	state.Available = types.BoolValue(false)
+	state.ID = types.StringValue("placeholder")
+
+	health, err := d.client.HealthWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Meilisearch Unavailable",
+			apierror.Describe(err),
+		)
+	} else {
+		state.Status = types.StringValue(health.Status)
+		state.Available = types.BoolValue(health.Status == healthStatusAvailable)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *healthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the data source")
+		return
+	}
+
+	d.client = data.client
+}
@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHealthDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "meilisearch_health" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meilisearch_health.test", "status", "available"),
+					resource.TestCheckResourceAttr("data.meilisearch_health.test", "available", "true"),
+					// Verify ID placeholder attribute is set
+					resource.TestCheckResourceAttr("data.meilisearch_health.test", "id", "placeholder"),
+				),
+			},
+		},
+	})
+}
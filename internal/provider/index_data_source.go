@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"terraform-provider-meilisearch/internal/apierror"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -79,11 +80,11 @@ func (d *indexDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	index, err := d.client.GetIndex(identifier.ValueString())
+	index, err := d.client.GetIndexWithContext(ctx, identifier.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Meilisearch index",
-			err.Error(),
+			apierror.Describe(err),
 		)
 		return
 	}
@@ -117,11 +118,12 @@ func (d *indexDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	var ok bool
-
-	d.client, ok = req.ProviderData.(meilisearch.ServiceManager)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		tflog.Error(ctx, "Type assertion failed when adding configured client to the data source")
+		return
 	}
+
+	d.client = data.client
 }
@@ -0,0 +1,720 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// embeddersMinVersion is the first Meilisearch version supporting embedders.
+var embeddersMinVersion = serverVersion{major: 1, minor: 6}
+
+// Embedder sources supported by Meilisearch.
+const (
+	embedderSourceOpenAI       = "openAi"
+	embedderSourceHuggingFace  = "huggingFace"
+	embedderSourceOllama       = "ollama"
+	embedderSourceREST         = "rest"
+	embedderSourceUserProvided = "userProvided"
+)
+
+// embedderFields lists, for each source, the optional and required
+// attributes of an embedder besides source and distribution.
+var embedderFields = map[string]struct {
+	optional []string
+	required []string
+}{
+	embedderSourceOpenAI: {
+		optional: []string{"model", "api_key", "document_template", "dimensions", "url"},
+	},
+	embedderSourceHuggingFace: {
+		optional: []string{"model", "revision", "document_template"},
+	},
+	embedderSourceOllama: {
+		optional: []string{"model", "api_key", "document_template", "dimensions", "url"},
+	},
+	embedderSourceREST: {
+		optional: []string{"api_key", "document_template", "dimensions", "headers"},
+		required: []string{"url", "request", "response"},
+	},
+	embedderSourceUserProvided: {
+		required: []string{"dimensions"},
+	},
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &indexEmbeddersResource{}
+	_ resource.ResourceWithConfigure      = &indexEmbeddersResource{}
+	_ resource.ResourceWithModifyPlan     = &indexEmbeddersResource{}
+	_ resource.ResourceWithImportState    = &indexEmbeddersResource{}
+	_ resource.ResourceWithValidateConfig = &indexEmbeddersResource{}
+)
+
+// NewIndexEmbeddersResource is a helper function to simplify the provider implementation.
+func NewIndexEmbeddersResource() resource.Resource {
+	return &indexEmbeddersResource{}
+}
+
+// indexEmbeddersResource is the resource implementation.
+type indexEmbeddersResource struct {
+	client       meilisearch.ServiceManager
+	providerData *providerData
+}
+
+type indexEmbeddersResourceModel struct {
+	IndexUID  types.String             `tfsdk:"index_uid"`
+	Embedders map[string]embedderModel `tfsdk:"embedders"`
+	ID        types.String             `tfsdk:"id"`
+	Timeouts  timeouts.Value           `tfsdk:"timeouts"`
+}
+
+type embedderModel struct {
+	Source           types.String       `tfsdk:"source"`
+	Model            types.String       `tfsdk:"model"`
+	APIKey           types.String       `tfsdk:"api_key"`
+	DocumentTemplate types.String       `tfsdk:"document_template"`
+	Dimensions       types.Int64        `tfsdk:"dimensions"`
+	URL              types.String       `tfsdk:"url"`
+	Revision         types.String       `tfsdk:"revision"`
+	Request          types.String       `tfsdk:"request"`
+	Response         types.String       `tfsdk:"response"`
+	Headers          types.Map          `tfsdk:"headers"`
+	Distribution     *distributionModel `tfsdk:"distribution"`
+}
+
+type distributionModel struct {
+	Mean  types.Float64 `tfsdk:"mean"`
+	Sigma types.Float64 `tfsdk:"sigma"`
+}
+
+// Metadata returns the resource type name.
+func (r *indexEmbeddersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_embedders"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexEmbeddersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the embedders of a Meilisearch index, used for AI-powered and hybrid search " +
+			"(see [official documentation](https://www.meilisearch.com/docs/reference/api/settings#embedders)). " +
+			"Attributes left out of the configuration are not checked for drift. Requires Meilisearch 1.6 or later.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"embedders": schema.MapNestedAttribute{
+				Description: "Embedders of the index, by name.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description: "Source of the embeddings, one of `openAi`, `huggingFace`, `ollama`, `rest` or `userProvided`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									embedderSourceOpenAI,
+									embedderSourceHuggingFace,
+									embedderSourceOllama,
+									embedderSourceREST,
+									embedderSourceUserProvided,
+								),
+							},
+						},
+						"model": schema.StringAttribute{
+							Description: "Model generating the embeddings (`openAi`, `huggingFace` and `ollama` only).",
+							Optional:    true,
+						},
+						"api_key": schema.StringAttribute{
+							Description: "API key used to query the embedder (`openAi`, `ollama` and `rest` only). " +
+								"Meilisearch only returns it masked, so changes made outside of Terraform are not detected.",
+							Optional:  true,
+							Sensitive: true,
+						},
+						"document_template": schema.StringAttribute{
+							Description: "Liquid template used to turn a document into the text to embed.",
+							Optional:    true,
+						},
+						"dimensions": schema.Int64Attribute{
+							Description: "Number of dimensions of the embeddings (required for `userProvided`).",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"url": schema.StringAttribute{
+							Description: "URL of the embedder (`openAi`, `ollama` and `rest` only, required for `rest`).",
+							Optional:    true,
+						},
+						"revision": schema.StringAttribute{
+							Description: "Revision (commit) of the model (`huggingFace` only).",
+							Optional:    true,
+						},
+						"request": schema.StringAttribute{
+							Description: "JSON template of the requests sent to the embedder, for instance built with `jsonencode` (`rest` only, required).",
+							Optional:    true,
+						},
+						"response": schema.StringAttribute{
+							Description: "JSON template of the responses of the embedder, for instance built with `jsonencode` (`rest` only, required).",
+							Optional:    true,
+						},
+						"headers": schema.MapAttribute{
+							Description: "Additional headers sent with each request to the embedder (`rest` only).",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"distribution": schema.SingleNestedAttribute{
+							Description: "Distribution of the semantic scores, used to correct the relevancy of the embedder.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"mean": schema.Float64Attribute{
+									Description: "Mean of the distribution.",
+									Required:    true,
+								},
+								"sigma": schema.Float64Attribute{
+									Description: "Standard deviation of the distribution.",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexEmbeddersResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+	r.providerData = data
+}
+
+// ModifyPlan fails the plan when the server does not support embedders.
+func (r *indexEmbeddersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(r.providerData.requireServerVersionForPlan(ctx, req, "Embedders", embeddersMinVersion, path.Root("embedders"))...)
+}
+
+// ValidateConfig checks that each embedder only sets the attributes supported
+// by its source.
+func (r *indexEmbeddersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var embedders types.Map
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("embedders"), &embedders)...)
+	if resp.Diagnostics.HasError() || embedders.IsNull() || embedders.IsUnknown() {
+		return
+	}
+
+	var models map[string]embedderModel
+
+	resp.Diagnostics.Append(embedders.ElementsAs(ctx, &models, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, embedder := range models {
+		if embedder.Source.IsUnknown() {
+			continue
+		}
+
+		embedderPath := path.Root("embedders").AtMapKey(name)
+		fields, ok := embedderFields[embedder.Source.ValueString()]
+		if !ok {
+			continue
+		}
+
+		values := embedder.fieldValues()
+
+		for field, value := range values {
+			if !value.IsNull() && !slices.Contains(fields.optional, field) && !slices.Contains(fields.required, field) {
+				resp.Diagnostics.AddAttributeError(
+					embedderPath.AtName(field),
+					"Unsupported Embedder Attribute",
+					fmt.Sprintf("%s is not supported by %s embedders.", field, embedder.Source.ValueString()),
+				)
+			}
+		}
+
+		for _, field := range fields.required {
+			if values[field].IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					embedderPath.AtName(field),
+					"Missing Embedder Attribute",
+					fmt.Sprintf("%s is required by %s embedders.", field, embedder.Source.ValueString()),
+				)
+			}
+		}
+
+		for field, value := range map[string]types.String{"request": embedder.Request, "response": embedder.Response} {
+			if value.IsNull() || value.IsUnknown() {
+				continue
+			}
+
+			var object map[string]any
+
+			if err := json.Unmarshal([]byte(value.ValueString()), &object); err != nil || object == nil {
+				resp.Diagnostics.AddAttributeError(
+					embedderPath.AtName(field),
+					"Invalid Embedder Template",
+					field+" must be a JSON object.",
+				)
+			}
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexEmbeddersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexEmbeddersResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyEmbedders(ctx, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue("placeholder")
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexEmbeddersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexEmbeddersResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed embedders from Meilisearch
+	embedders, err := r.client.Index(state.IndexUID.ValueString()).GetEmbeddersWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Reading Meilisearch Index Embedders",
+				"Could not read embedders of Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(flattenEmbedders(ctx, embedders, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexEmbeddersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state indexEmbeddersResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyEmbedders(ctx, &plan, embeddersNeedReset(state.Embedders, plan.Embedders))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexEmbeddersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexEmbeddersResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	task, err := r.client.Index(state.IndexUID.ValueString()).ResetEmbeddersWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Resetting Meilisearch Index Embedders",
+			"Could not reset index embedders, unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+}
+
+func (r *indexEmbeddersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import UID and save to index_uid attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("index_uid"), req, resp)
+}
+
+// applyEmbedders sends the planned embedders and waits for the resulting
+// tasks. Meilisearch merges the embedders sent with the existing ones, and
+// the SDK cannot send null to remove a single embedder or attribute, so
+// embedders are reset first when anything has to be removed.
+func (r *indexEmbeddersResource) applyEmbedders(ctx context.Context, plan *indexEmbeddersResourceModel, reset bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	index := r.client.Index(plan.IndexUID.ValueString())
+
+	embedders, d := expandEmbedders(ctx, plan.Embedders)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if reset {
+		task, err := index.ResetEmbeddersWithContext(ctx)
+		if err != nil {
+			diags.AddError(
+				"Error Resetting Meilisearch Index Embedders",
+				"Could not reset index embedders, unexpected error: "+apierror.Describe(err),
+			)
+			return diags
+		}
+
+		diags.Append(waitForTask(ctx, r.client, task)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if len(embedders) == 0 {
+		return diags
+	}
+
+	task, err := index.UpdateEmbeddersWithContext(ctx, embedders)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Meilisearch Index Embedders",
+			"Could not update index embedders, unexpected error: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(waitForTask(ctx, r.client, task)...)
+
+	return diags
+}
+
+// embeddersNeedReset reports whether an embedder or one of its attributes
+// was removed, or whether the source of an embedder changed.
+func embeddersNeedReset(state, plan map[string]embedderModel) bool {
+	for name, previous := range state {
+		embedder, ok := plan[name]
+		if !ok || !previous.Source.Equal(embedder.Source) {
+			return true
+		}
+
+		if previous.Distribution != nil && embedder.Distribution == nil {
+			return true
+		}
+
+		values := embedder.fieldValues()
+
+		for field, value := range previous.fieldValues() {
+			if !value.IsNull() && values[field].IsNull() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func expandEmbedders(ctx context.Context, models map[string]embedderModel) (map[string]meilisearch.Embedder, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	embedders := make(map[string]meilisearch.Embedder, len(models))
+
+	for name, model := range models {
+		embedder := meilisearch.Embedder{
+			Source:           meilisearch.EmbedderSource(model.Source.ValueString()),
+			Model:            model.Model.ValueString(),
+			APIKey:           model.APIKey.ValueString(),
+			DocumentTemplate: model.DocumentTemplate.ValueString(),
+			Dimensions:       int(model.Dimensions.ValueInt64()),
+			URL:              model.URL.ValueString(),
+			Revision:         model.Revision.ValueString(),
+		}
+
+		for field, target := range map[string]*map[string]any{"request": &embedder.Request, "response": &embedder.Response} {
+			value := model.fieldValues()[field].(types.String)
+			if value.IsNull() {
+				continue
+			}
+
+			if err := json.Unmarshal([]byte(value.ValueString()), target); err != nil {
+				diags.AddAttributeError(
+					path.Root("embedders").AtMapKey(name).AtName(field),
+					"Invalid Embedder Template",
+					field+" must be a JSON object: "+err.Error(),
+				)
+			}
+		}
+
+		if !model.Headers.IsNull() {
+			diags.Append(model.Headers.ElementsAs(ctx, &embedder.Headers, false)...)
+		}
+
+		if model.Distribution != nil {
+			embedder.Distribution = &meilisearch.Distribution{
+				Mean:  model.Distribution.Mean.ValueFloat64(),
+				Sigma: model.Distribution.Sigma.ValueFloat64(),
+			}
+		}
+
+		embedders[name] = embedder
+	}
+
+	return embedders, diags
+}
+
+// flattenEmbedders refreshes the embedders of the model. Attributes missing
+// from the previous state are only read back on import, so that default
+// values set by Meilisearch do not show up as drift. API keys are returned
+// masked and are therefore kept from the previous state.
+func flattenEmbedders(ctx context.Context, embedders map[string]meilisearch.Embedder, model *indexEmbeddersResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	previous := model.Embedders
+	imported := previous == nil
+
+	model.Embedders = make(map[string]embedderModel, len(embedders))
+
+	for name, embedder := range embedders {
+		prior, managed := previous[name]
+		all := imported || !managed
+
+		refreshed := embedderModel{
+			Source:           types.StringValue(string(embedder.Source)),
+			APIKey:           prior.APIKey,
+			Model:            refreshString(prior.Model, embedder.Model, all),
+			DocumentTemplate: refreshString(prior.DocumentTemplate, embedder.DocumentTemplate, all),
+			URL:              refreshString(prior.URL, embedder.URL, all),
+			Revision:         refreshString(prior.Revision, embedder.Revision, all),
+			Headers:          types.MapNull(types.StringType),
+		}
+
+		if refreshed.APIKey.IsUnknown() {
+			refreshed.APIKey = types.StringNull()
+		}
+
+		if (all || !prior.Dimensions.IsNull()) && embedder.Dimensions != 0 {
+			refreshed.Dimensions = types.Int64Value(int64(embedder.Dimensions))
+		} else {
+			refreshed.Dimensions = types.Int64Null()
+		}
+
+		for field, value := range map[string]map[string]any{"request": embedder.Request, "response": embedder.Response} {
+			priorValue := prior.fieldValues()[field].(types.String)
+
+			if !(all || !priorValue.IsNull()) || value == nil {
+				refreshed.setTemplate(field, types.StringNull())
+				continue
+			}
+
+			refreshed.setTemplate(field, refreshJSON(priorValue, value))
+		}
+
+		if (all || !prior.Headers.IsNull()) && len(embedder.Headers) > 0 {
+			var d diag.Diagnostics
+			refreshed.Headers, d = types.MapValueFrom(ctx, types.StringType, embedder.Headers)
+			diags.Append(d...)
+		}
+
+		if (all || prior.Distribution != nil) && embedder.Distribution != nil {
+			refreshed.Distribution = &distributionModel{
+				Mean:  types.Float64Value(embedder.Distribution.Mean),
+				Sigma: types.Float64Value(embedder.Distribution.Sigma),
+			}
+		}
+
+		model.Embedders[name] = refreshed
+	}
+
+	return diags
+}
+
+// fieldValues returns the optional attributes of the embedder, by name.
+func (m embedderModel) fieldValues() map[string]attr.Value {
+	return map[string]attr.Value{
+		"model":             m.Model,
+		"api_key":           m.APIKey,
+		"document_template": m.DocumentTemplate,
+		"dimensions":        m.Dimensions,
+		"url":               m.URL,
+		"revision":          m.Revision,
+		"request":           m.Request,
+		"response":          m.Response,
+		"headers":           m.Headers,
+	}
+}
+
+func (m *embedderModel) setTemplate(field string, value types.String) {
+	if field == "request" {
+		m.Request = value
+	} else {
+		m.Response = value
+	}
+}
+
+// refreshString returns the value read from Meilisearch when the attribute
+// is managed, an empty value being read as null.
+func refreshString(prior types.String, value string, all bool) types.String {
+	if (!all && prior.IsNull()) || value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// refreshJSON returns the previous JSON value when it is semantically equal
+// to the value read from Meilisearch, so that formatting is preserved.
+func refreshJSON(prior types.String, value any) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var previous any
+
+		if err := json.Unmarshal([]byte(prior.ValueString()), &previous); err == nil {
+			// Round trip the value read back so that numbers compare equal
+			encoded, _ := json.Marshal(value)
+
+			var current any
+
+			if err := json.Unmarshal(encoded, &current); err == nil && reflect.DeepEqual(previous, current) {
+				return prior
+			}
+		}
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(string(encoded))
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+func TestAccIndexEmbeddersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "embedders-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_embedders" "test" {
+	index_uid = meilisearch_index.test.uid
+	embedders = {
+		manual = {
+			source = "userProvided"
+			dimensions = 3
+		}
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_embedders.test", "index_uid", "embedders-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_index_embedders.test", "embedders.manual.source", "userProvided"),
+					resource.TestCheckResourceAttr("meilisearch_index_embedders.test", "embedders.manual.dimensions", "3"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "meilisearch_index_embedders.test",
+				ImportStateId:                        "embedders-index-uid",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index_uid",
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "embedders-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_embedders" "test" {
+	index_uid = meilisearch_index.test.uid
+	embedders = {
+		other = {
+			source = "userProvided"
+			dimensions = 2
+			distribution = {
+				mean = 0.7
+				sigma = 0.3
+			}
+		}
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("meilisearch_index_embedders.test", "embedders.manual.source"),
+					resource.TestCheckResourceAttr("meilisearch_index_embedders.test", "embedders.other.dimensions", "2"),
+					resource.TestCheckResourceAttr("meilisearch_index_embedders.test", "embedders.other.distribution.mean", "0.7"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestFlattenEmbedders(t *testing.T) {
+	embedders := map[string]meilisearch.Embedder{
+		"default": {
+			Source:           embedderSourceOpenAI,
+			Model:            "text-embedding-3-small",
+			APIKey:           "sk-X...",
+			DocumentTemplate: "{{doc.title}}",
+			Dimensions:       1536,
+		},
+		"rest": {
+			Source:   embedderSourceREST,
+			URL:      "http://localhost:8080/embed",
+			Request:  map[string]any{"input": "{{text}}"},
+			Response: map[string]any{"embedding": "{{embedding}}"},
+		},
+	}
+
+	model := indexEmbeddersResourceModel{
+		Embedders: map[string]embedderModel{
+			"default": {
+				Source:           types.StringValue(embedderSourceOpenAI),
+				APIKey:           types.StringValue("sk-secret"),
+				DocumentTemplate: types.StringValue("{{doc.title}}"),
+				Headers:          types.MapNull(types.StringType),
+			},
+			"rest": {
+				Source:   types.StringValue(embedderSourceREST),
+				URL:      types.StringValue("http://localhost:8080/embed"),
+				Request:  types.StringValue(`{ "input" : "{{text}}" }`),
+				Response: types.StringValue(`{"embedding":"{{other}}"}`),
+				Headers:  types.MapNull(types.StringType),
+			},
+		},
+	}
+
+	if diags := flattenEmbedders(context.Background(), embedders, &model); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	openAI := model.Embedders["default"]
+
+	if openAI.APIKey.ValueString() != "sk-secret" {
+		t.Errorf("masked API key should be kept from state, got %s", openAI.APIKey)
+	}
+
+	if !openAI.Model.IsNull() || !openAI.Dimensions.IsNull() {
+		t.Errorf("unmanaged attributes should stay null, got %s and %s", openAI.Model, openAI.Dimensions)
+	}
+
+	rest := model.Embedders["rest"]
+
+	if rest.Request.ValueString() != `{ "input" : "{{text}}" }` {
+		t.Errorf("equivalent request template should be kept from state, got %s", rest.Request)
+	}
+
+	if rest.Response.ValueString() != `{"embedding":"{{embedding}}"}` {
+		t.Errorf("changed response template should be read back, got %s", rest.Response)
+	}
+
+	// On import, every attribute but API keys is read back
+	imported := indexEmbeddersResourceModel{}
+
+	if diags := flattenEmbedders(context.Background(), embedders, &imported); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if imported.Embedders["default"].Model.ValueString() != "text-embedding-3-small" || !imported.Embedders["default"].APIKey.IsNull() {
+		t.Errorf("unexpected imported embedder %+v", imported.Embedders["default"])
+	}
+}
+
+func TestEmbeddersNeedReset(t *testing.T) {
+	state := map[string]embedderModel{
+		"default": {
+			Source:           types.StringValue(embedderSourceOpenAI),
+			DocumentTemplate: types.StringValue("{{doc.title}}"),
+		},
+	}
+
+	for name, testCase := range map[string]struct {
+		plan     map[string]embedderModel
+		expected bool
+	}{
+		"unchanged": {
+			plan:     state,
+			expected: false,
+		},
+		"added attribute": {
+			plan: map[string]embedderModel{"default": {
+				Source:           types.StringValue(embedderSourceOpenAI),
+				DocumentTemplate: types.StringValue("{{doc.title}}"),
+				Model:            types.StringValue("text-embedding-3-large"),
+			}},
+			expected: false,
+		},
+		"removed attribute": {
+			plan:     map[string]embedderModel{"default": {Source: types.StringValue(embedderSourceOpenAI)}},
+			expected: true,
+		},
+		"changed source": {
+			plan:     map[string]embedderModel{"default": {Source: types.StringValue(embedderSourceOllama), DocumentTemplate: types.StringValue("{{doc.title}}")}},
+			expected: true,
+		},
+		"removed embedder": {
+			plan:     map[string]embedderModel{},
+			expected: true,
+		},
+	} {
+		if embeddersNeedReset(state, testCase.plan) != testCase.expected {
+			t.Errorf("%s: expected %t", name, testCase.expected)
+		}
+	}
+}
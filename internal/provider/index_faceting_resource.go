@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Default faceting settings, as documented by Meilisearch.
+const (
+	defaultMaxValuesPerFacet = 100
+	allFacetsAttribute       = "*"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &indexFacetingResource{}
+	_ resource.ResourceWithConfigure   = &indexFacetingResource{}
+	_ resource.ResourceWithImportState = &indexFacetingResource{}
+)
+
+// NewIndexFacetingResource is a helper function to simplify the provider implementation.
+func NewIndexFacetingResource() resource.Resource {
+	return &indexFacetingResource{}
+}
+
+// indexFacetingResource is the resource implementation.
+type indexFacetingResource struct {
+	client meilisearch.ServiceManager
+}
+
+type indexFacetingResourceModel struct {
+	IndexUID          types.String   `tfsdk:"index_uid"`
+	MaxValuesPerFacet types.Int64    `tfsdk:"max_values_per_facet"`
+	SortFacetValuesBy types.Map      `tfsdk:"sort_facet_values_by"`
+	ID                types.String   `tfsdk:"id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *indexFacetingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_faceting"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexFacetingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the faceting settings of a Meilisearch index " +
+			"(see [official documentation](https://www.meilisearch.com/docs/reference/api/settings#faceting)). " +
+			"Attributes omitted from the configuration are set to their default value.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_values_per_facet": schema.Int64Attribute{
+				Description: "Maximum number of values returned for each facet. Defaults to `100`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultMaxValuesPerFacet),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"sort_facet_values_by": schema.MapAttribute{
+				Description: "Sort order of facet values (`alpha` or `count`) by attribute name, `*` matching all attributes. " +
+					"Attributes not listed are sorted by `alpha` unless `*` says otherwise. Defaults to `{ \"*\" = \"alpha\" }`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default: mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{
+					allFacetsAttribute: types.StringValue(string(meilisearch.SortFacetTypeAlpha)),
+				})),
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(
+						string(meilisearch.SortFacetTypeAlpha),
+						string(meilisearch.SortFacetTypeCount),
+					)),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexFacetingResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexFacetingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexFacetingResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Reset first, as attributes sorted by a previous configuration would otherwise be kept
+	resp.Diagnostics.Append(r.applyFaceting(ctx, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexFacetingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexFacetingResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed faceting from Meilisearch
+	faceting, err := r.client.Index(state.IndexUID.ValueString()).GetFacetingWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Reading Meilisearch Index Faceting",
+				"Could not read faceting of Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+			)
+			return
+		}
+	}
+
+	state.MaxValuesPerFacet = types.Int64Value(faceting.MaxValuesPerFacet)
+
+	state.SortFacetValuesBy, diags = types.MapValueFrom(ctx, types.StringType, flattenSortFacetValuesBy(state.SortFacetValuesBy, faceting.SortFacetValuesBy))
+	resp.Diagnostics.Append(diags...)
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexFacetingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state indexFacetingResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Sort orders are merged by Meilisearch, so removing attributes requires a reset
+	reset := false
+
+	for attribute := range state.SortFacetValuesBy.Elements() {
+		if _, ok := plan.SortFacetValuesBy.Elements()[attribute]; !ok {
+			reset = true
+		}
+	}
+
+	resp.Diagnostics.Append(r.applyFaceting(ctx, &plan, reset)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexFacetingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexFacetingResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	task, err := r.client.Index(state.IndexUID.ValueString()).ResetFacetingWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Resetting Meilisearch Index Faceting",
+			"Could not reset index faceting, unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+}
+
+func (r *indexFacetingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import UID and save to index_uid attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("index_uid"), req, resp)
+}
+
+// applyFaceting sends the planned faceting, optionally resetting it
+// beforehand, and waits for the resulting tasks.
+func (r *indexFacetingResource) applyFaceting(ctx context.Context, plan *indexFacetingResourceModel, reset bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	index := r.client.Index(plan.IndexUID.ValueString())
+
+	var sortFacetValuesBy map[string]string
+
+	diags.Append(plan.SortFacetValuesBy.ElementsAs(ctx, &sortFacetValuesBy, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	faceting := meilisearch.Faceting{
+		MaxValuesPerFacet: plan.MaxValuesPerFacet.ValueInt64(),
+		SortFacetValuesBy: make(map[string]meilisearch.SortFacetType, len(sortFacetValuesBy)),
+	}
+
+	for attribute, sort := range sortFacetValuesBy {
+		faceting.SortFacetValuesBy[attribute] = meilisearch.SortFacetType(sort)
+	}
+
+	if reset {
+		task, err := index.ResetFacetingWithContext(ctx)
+		if err != nil {
+			diags.AddError(
+				"Error Resetting Meilisearch Index Faceting",
+				"Could not reset index faceting, unexpected error: "+apierror.Describe(err),
+			)
+			return diags
+		}
+
+		diags.Append(waitForTask(ctx, r.client, task)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	task, err := index.UpdateFacetingWithContext(ctx, &faceting)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Meilisearch Index Faceting",
+			"Could not update index faceting, unexpected error: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(waitForTask(ctx, r.client, task)...)
+
+	plan.ID = types.StringValue("placeholder")
+
+	return diags
+}
+
+// flattenSortFacetValuesBy returns the sort orders read from Meilisearch. The
+// default order of all attributes, always returned by Meilisearch, is only
+// kept when it was part of the previous value.
+func flattenSortFacetValuesBy(previous types.Map, sortFacetValuesBy map[string]meilisearch.SortFacetType) map[string]string {
+	flattened := make(map[string]string, len(sortFacetValuesBy))
+
+	for attribute, sort := range sortFacetValuesBy {
+		flattened[attribute] = string(sort)
+	}
+
+	_, managed := previous.Elements()[allFacetsAttribute]
+	if !managed && !previous.IsNull() && flattened[allFacetsAttribute] == string(meilisearch.SortFacetTypeAlpha) {
+		delete(flattened, allFacetsAttribute)
+	}
+
+	return flattened
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+func TestAccIndexFacetingResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "faceting-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_faceting" "test" {
+	index_uid = meilisearch_index.test.uid
+	max_values_per_facet = 500
+	sort_facet_values_by = {
+		size = "count"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_faceting.test", "index_uid", "faceting-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_index_faceting.test", "max_values_per_facet", "500"),
+					resource.TestCheckResourceAttr("meilisearch_index_faceting.test", "sort_facet_values_by.%", "1"),
+					resource.TestCheckResourceAttr("meilisearch_index_faceting.test", "sort_facet_values_by.size", "count"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "meilisearch_index_faceting.test",
+				ImportStateId:                        "faceting-index-uid",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index_uid",
+				ImportStateVerifyIgnore:              []string{"sort_facet_values_by", "timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "faceting-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_faceting" "test" {
+	index_uid = meilisearch_index.test.uid
+	sort_facet_values_by = {
+		"*" = "count"
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_faceting.test", "max_values_per_facet", "100"),
+					resource.TestCheckResourceAttr("meilisearch_index_faceting.test", "sort_facet_values_by.%", "1"),
+					resource.TestCheckResourceAttr("meilisearch_index_faceting.test", "sort_facet_values_by.*", "count"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestFlattenSortFacetValuesBy(t *testing.T) {
+	read := map[string]meilisearch.SortFacetType{
+		"*":    meilisearch.SortFacetTypeAlpha,
+		"size": meilisearch.SortFacetTypeCount,
+	}
+
+	cases := map[string]struct {
+		previous types.Map
+		expected map[string]string
+	}{
+		"imported": {
+			previous: types.MapNull(types.StringType),
+			expected: map[string]string{"*": "alpha", "size": "count"},
+		},
+		"all attributes managed": {
+			previous: types.MapValueMust(types.StringType, map[string]attr.Value{"*": types.StringValue("alpha")}),
+			expected: map[string]string{"*": "alpha", "size": "count"},
+		},
+		"all attributes not managed": {
+			previous: types.MapValueMust(types.StringType, map[string]attr.Value{"size": types.StringValue("count")}),
+			expected: map[string]string{"size": "count"},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			flattened := flattenSortFacetValuesBy(c.previous, read)
+
+			if !reflect.DeepEqual(flattened, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, flattened)
+			}
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// localizedAttributesMinVersion is the first Meilisearch version supporting
+// localized attributes.
+var localizedAttributesMinVersion = serverVersion{major: 1, minor: 10}
+
+// supportedLocales are the ISO-639-1 and ISO-639-3 codes of the languages
+// supported by Meilisearch.
+var supportedLocales = []string{
+	"af", "ak", "am", "ar", "az", "be", "bg", "bn", "ca", "cs", "da", "de", "el", "en", "eo", "es",
+	"et", "fa", "fi", "fr", "gu", "he", "hi", "hr", "hu", "hy", "id", "it", "ja", "jv", "ka", "km",
+	"kn", "ko", "la", "lt", "lv", "mk", "ml", "mr", "my", "nb", "ne", "nl", "or", "pa", "pl", "pt",
+	"ro", "ru", "si", "sk", "sl", "sn", "sr", "sv", "ta", "te", "th", "tk", "tl", "tr", "uk", "ur",
+	"uz", "vi", "yi", "zh", "zu",
+	"afr", "aka", "amh", "ara", "aze", "bel", "ben", "bul", "cat", "ces", "cmn", "dan", "deu", "ell",
+	"eng", "epo", "est", "fin", "fra", "guj", "heb", "hin", "hrv", "hun", "hye", "ind", "ita", "jav",
+	"jpn", "kan", "kat", "khm", "kor", "lat", "lav", "lit", "mal", "mar", "mkd", "mya", "nep", "nld",
+	"nob", "ori", "pan", "pes", "pol", "por", "ron", "rus", "sin", "slk", "slv", "sna", "spa", "srp",
+	"swe", "tam", "tel", "tgl", "tha", "tuk", "tur", "ukr", "urd", "uzb", "vie", "yid", "zho", "zul",
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &indexLocalizedAttributesResource{}
+	_ resource.ResourceWithConfigure   = &indexLocalizedAttributesResource{}
+	_ resource.ResourceWithModifyPlan  = &indexLocalizedAttributesResource{}
+	_ resource.ResourceWithImportState = &indexLocalizedAttributesResource{}
+)
+
+// NewIndexLocalizedAttributesResource is a helper function to simplify the provider implementation.
+func NewIndexLocalizedAttributesResource() resource.Resource {
+	return &indexLocalizedAttributesResource{}
+}
+
+// indexLocalizedAttributesResource is the resource implementation.
+type indexLocalizedAttributesResource struct {
+	client       meilisearch.ServiceManager
+	providerData *providerData
+}
+
+type indexLocalizedAttributesResourceModel struct {
+	IndexUID            types.String   `tfsdk:"index_uid"`
+	LocalizedAttributes types.List     `tfsdk:"localized_attributes"`
+	ID                  types.String   `tfsdk:"id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *indexLocalizedAttributesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_localized_attributes"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexLocalizedAttributesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the locales used for the attributes of a Meilisearch index " +
+			"(see [official documentation](https://www.meilisearch.com/docs/reference/api/settings#localized-attributes)). " +
+			"Requires Meilisearch 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"localized_attributes": schema.ListNestedAttribute{
+				Description: "Locale rules, the first rule whose patterns match an attribute applying to it.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute_patterns": schema.ListAttribute{
+							Description: "Patterns of the attributes the locales apply to, such as `title_ja` or `*_ja`.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+						"locales": schema.ListAttribute{
+							Description: "ISO-639 codes of the locales of the matching attributes, such as `jpn` or `ja`. " +
+								"An empty list lets Meilisearch detect the language.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.OneOf(supportedLocales...)),
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexLocalizedAttributesResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+	r.providerData = data
+}
+
+// ModifyPlan fails the plan when the server does not support localized attributes.
+func (r *indexLocalizedAttributesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(r.providerData.requireServerVersionForPlan(ctx, req, "Localized attributes", localizedAttributesMinVersion, path.Root("localized_attributes"))...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexLocalizedAttributesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexLocalizedAttributesResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyLocalizedAttributes(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexLocalizedAttributesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexLocalizedAttributesResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed localized attributes from Meilisearch
+	localizedAttributes, err := r.client.Index(state.IndexUID.ValueString()).GetLocalizedAttributesWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Reading Meilisearch Index Localized Attributes",
+				"Could not read localized attributes of Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+			)
+			return
+		}
+	}
+
+	state.LocalizedAttributes, diags = flattenLocalizedAttributes(ctx, localizedAttributes)
+	resp.Diagnostics.Append(diags...)
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexLocalizedAttributesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan indexLocalizedAttributesResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyLocalizedAttributes(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexLocalizedAttributesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexLocalizedAttributesResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	task, err := r.client.Index(state.IndexUID.ValueString()).ResetLocalizedAttributesWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Resetting Meilisearch Index Localized Attributes",
+			"Could not reset index localized attributes, unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+}
+
+func (r *indexLocalizedAttributesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import UID and save to index_uid attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("index_uid"), req, resp)
+}
+
+// applyLocalizedAttributes replaces the localized attributes of the index with
+// the planned ones and waits for the resulting task. An empty list resets them.
+func (r *indexLocalizedAttributesResource) applyLocalizedAttributes(ctx context.Context, plan *indexLocalizedAttributesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var rules []localizedAttributeModel
+
+	diags.Append(plan.LocalizedAttributes.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	localizedAttributes := make([]*meilisearch.LocalizedAttributes, 0, len(rules))
+
+	for _, rule := range rules {
+		localizedAttribute := &meilisearch.LocalizedAttributes{}
+
+		diags.Append(rule.AttributePatterns.ElementsAs(ctx, &localizedAttribute.AttributePatterns, false)...)
+		diags.Append(rule.Locales.ElementsAs(ctx, &localizedAttribute.Locales, false)...)
+
+		localizedAttributes = append(localizedAttributes, localizedAttribute)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	index := r.client.Index(plan.IndexUID.ValueString())
+
+	var task *meilisearch.TaskInfo
+	var err error
+
+	if len(localizedAttributes) == 0 {
+		task, err = index.ResetLocalizedAttributesWithContext(ctx)
+	} else {
+		task, err = index.UpdateLocalizedAttributesWithContext(ctx, localizedAttributes)
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Error Updating Meilisearch Index Localized Attributes",
+			"Could not update index localized attributes, unexpected error: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(waitForTask(ctx, r.client, task)...)
+
+	plan.ID = types.StringValue("placeholder")
+
+	return diags
+}
+
+// flattenLocalizedAttributes converts the localized attributes read from
+// Meilisearch, treating missing rules as an empty list.
+func flattenLocalizedAttributes(ctx context.Context, localizedAttributes []*meilisearch.LocalizedAttributes) (types.List, diag.Diagnostics) {
+	rules := make([]localizedAttributeModel, 0, len(localizedAttributes))
+
+	var diags diag.Diagnostics
+
+	for _, localizedAttribute := range localizedAttributes {
+		if localizedAttribute == nil {
+			continue
+		}
+
+		attributePatterns, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(localizedAttribute.AttributePatterns))
+		diags.Append(d...)
+
+		locales, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(localizedAttribute.Locales))
+		diags.Append(d...)
+
+		rules = append(rules, localizedAttributeModel{
+			AttributePatterns: attributePatterns,
+			Locales:           locales,
+		})
+	}
+
+	list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: localizedAttributeAttrTypes}, rules)
+	diags.Append(d...)
+
+	return list, diags
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexLocalizedAttributesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index_localized_attributes" "test" {
+	index_uid = "localized-attributes-index-uid"
+	localized_attributes = [
+		{
+			attribute_patterns = ["*_xx"]
+			locales = ["xxx"]
+		},
+	]
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "localized-attributes-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_localized_attributes" "test" {
+	index_uid = meilisearch_index.test.uid
+	localized_attributes = [
+		{
+			attribute_patterns = ["*_ja"]
+			locales = ["jpn"]
+		},
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_localized_attributes.test", "index_uid", "localized-attributes-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_index_localized_attributes.test", "localized_attributes.#", "1"),
+					resource.TestCheckResourceAttr("meilisearch_index_localized_attributes.test", "localized_attributes.0.locales.0", "jpn"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "meilisearch_index_localized_attributes.test",
+				ImportStateId:                        "localized-attributes-index-uid",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index_uid",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "localized-attributes-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_localized_attributes" "test" {
+	index_uid = meilisearch_index.test.uid
+	localized_attributes = [
+		{
+			attribute_patterns = ["*_fr"]
+			locales = ["fra"]
+		},
+		{
+			attribute_patterns = ["*"]
+			locales = []
+		},
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_localized_attributes.test", "localized_attributes.#", "2"),
+					resource.TestCheckResourceAttr("meilisearch_index_localized_attributes.test", "localized_attributes.1.locales.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// defaultMaxTotalHits is the default maximum number of search results, as
+// documented by Meilisearch.
+const defaultMaxTotalHits = 1000
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &indexPaginationResource{}
+	_ resource.ResourceWithConfigure   = &indexPaginationResource{}
+	_ resource.ResourceWithImportState = &indexPaginationResource{}
+)
+
+// NewIndexPaginationResource is a helper function to simplify the provider implementation.
+func NewIndexPaginationResource() resource.Resource {
+	return &indexPaginationResource{}
+}
+
+// indexPaginationResource is the resource implementation.
+type indexPaginationResource struct {
+	client meilisearch.ServiceManager
+}
+
+type indexPaginationResourceModel struct {
+	IndexUID     types.String   `tfsdk:"index_uid"`
+	MaxTotalHits types.Int64    `tfsdk:"max_total_hits"`
+	ID           types.String   `tfsdk:"id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *indexPaginationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_pagination"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexPaginationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the pagination settings of a Meilisearch index " +
+			"(see [official documentation](https://www.meilisearch.com/docs/reference/api/settings#pagination)).",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_total_hits": schema.Int64Attribute{
+				Description: "Maximum number of search results returned for a query. Defaults to `1000`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultMaxTotalHits),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexPaginationResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexPaginationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexPaginationResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyPagination(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexPaginationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexPaginationResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed pagination from Meilisearch
+	pagination, err := r.client.Index(state.IndexUID.ValueString()).GetPaginationWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Reading Meilisearch Index Pagination",
+				"Could not read pagination of Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+			)
+			return
+		}
+	}
+
+	state.MaxTotalHits = types.Int64Value(pagination.MaxTotalHits)
+	state.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexPaginationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan indexPaginationResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyPagination(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexPaginationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexPaginationResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	task, err := r.client.Index(state.IndexUID.ValueString()).ResetPaginationWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Resetting Meilisearch Index Pagination",
+			"Could not reset index pagination, unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+}
+
+func (r *indexPaginationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import UID and save to index_uid attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("index_uid"), req, resp)
+}
+
+// applyPagination sends the planned pagination and waits for the resulting task.
+func (r *indexPaginationResource) applyPagination(ctx context.Context, plan *indexPaginationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pagination := meilisearch.Pagination{
+		MaxTotalHits: plan.MaxTotalHits.ValueInt64(),
+	}
+
+	task, err := r.client.Index(plan.IndexUID.ValueString()).UpdatePaginationWithContext(ctx, &pagination)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Meilisearch Index Pagination",
+			"Could not update index pagination, unexpected error: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(waitForTask(ctx, r.client, task)...)
+
+	plan.ID = types.StringValue("placeholder")
+
+	return diags
+}
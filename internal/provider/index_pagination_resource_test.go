@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexPaginationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "pagination-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_pagination" "test" {
+	index_uid = meilisearch_index.test.uid
+	max_total_hits = 5000
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_pagination.test", "index_uid", "pagination-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_index_pagination.test", "max_total_hits", "5000"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "meilisearch_index_pagination.test",
+				ImportStateId:                        "pagination-index-uid",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index_uid",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "pagination-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_pagination" "test" {
+	index_uid = meilisearch_index.test.uid
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_pagination.test", "max_total_hits", "1000"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,561 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// managedSettingsPrivateKey is the private state key holding the names of the
+// settings set in the configuration, which are the only ones reset on destroy.
+const managedSettingsPrivateKey = "managed_settings"
+
+// Prefix search modes supported by Meilisearch.
+const (
+	prefixSearchIndexingTime = "indexingTime"
+	prefixSearchDisabled     = "disabled"
+)
+
+// facetAndPrefixSearchMinVersion is the first Meilisearch version supporting
+// the facet search and prefix search settings.
+var facetAndPrefixSearchMinVersion = serverVersion{major: 1, minor: 12}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &indexPerformanceSettingsResource{}
+	_ resource.ResourceWithConfigure   = &indexPerformanceSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &indexPerformanceSettingsResource{}
+	_ resource.ResourceWithImportState = &indexPerformanceSettingsResource{}
+)
+
+// NewIndexPerformanceSettingsResource is a helper function to simplify the provider implementation.
+func NewIndexPerformanceSettingsResource() resource.Resource {
+	return &indexPerformanceSettingsResource{}
+}
+
+// indexPerformanceSettingsResource is the resource implementation.
+type indexPerformanceSettingsResource struct {
+	client       meilisearch.ServiceManager
+	providerData *providerData
+}
+
+type indexPerformanceSettingsResourceModel struct {
+	IndexUID       types.String   `tfsdk:"index_uid"`
+	SearchCutoffMs types.Int64    `tfsdk:"search_cutoff_ms"`
+	FacetSearch    types.Bool     `tfsdk:"facet_search"`
+	PrefixSearch   types.String   `tfsdk:"prefix_search"`
+	ID             types.String   `tfsdk:"id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *indexPerformanceSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_performance_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexPerformanceSettingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of a Meilisearch index trading relevancy or features for search latency and indexing cost. " +
+			"Settings omitted from the configuration are left untouched and read back from Meilisearch, " +
+			"and destroying the resource only resets the settings set in the configuration.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"search_cutoff_ms": schema.Int64Attribute{
+				Description: "Maximum duration of a search query, in milliseconds. Meilisearch uses 1500 milliseconds when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"facet_search": schema.BoolAttribute{
+				Description: "Whether facet search is enabled. Disabling it speeds up indexing. Requires Meilisearch 1.12 or later.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefix_search": schema.StringAttribute{
+				Description: "When prefix search is computed: `indexingTime`, or `disabled` to speed up indexing at the cost of " +
+					"less relevant results for incomplete words. Requires Meilisearch 1.12 or later.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(prefixSearchIndexingTime, prefixSearchDisabled),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexPerformanceSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+	r.providerData = data
+}
+
+// ModifyPlan fails the plan when facet search or prefix search is configured
+// and the server does not support them.
+func (r *indexPerformanceSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Config.Raw.IsNull() {
+		return
+	}
+
+	var config indexPerformanceSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.FacetSearch.IsNull() && config.PrefixSearch.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.providerData.requireServerVersionForPlan(ctx, req, "Facet search and prefix search settings", facetAndPrefixSearchMinVersion,
+		path.Root("facet_search"), path.Root("prefix_search"))...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexPerformanceSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan and config
+	var plan, config indexPerformanceSettingsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyPerformanceSettings(ctx, &plan, &config, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setManagedSettings(ctx, resp.Private, config.managedSettings())...)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexPerformanceSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexPerformanceSettingsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.readPerformanceSettings(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexPerformanceSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan, config and state
+	var plan, config, state indexPerformanceSettingsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyPerformanceSettings(ctx, &plan, &config, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setManagedSettings(ctx, resp.Private, config.managedSettings())...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexPerformanceSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexPerformanceSettingsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := getManagedSettings(ctx, req.Private)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	index := r.client.Index(state.IndexUID.ValueString())
+
+	resets := map[string]func(context.Context) (*meilisearch.TaskInfo, error){
+		"search_cutoff_ms": index.ResetSearchCutoffMsWithContext,
+		"facet_search":     index.ResetFacetSearchWithContext,
+		"prefix_search":    index.ResetPrefixSearchWithContext,
+	}
+
+	var tasks []meilisearch.TaskInfo
+
+	for _, setting := range managed {
+		reset, ok := resets[setting]
+		if !ok {
+			continue
+		}
+
+		task, err := reset(ctx)
+		if err != nil {
+			if apierror.IsNotFound(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Resetting Meilisearch Index Performance Settings",
+				"Could not reset index "+setting+", unexpected error: "+apierror.Describe(err),
+			)
+			return
+		}
+
+		tasks = append(tasks, *task)
+	}
+
+	resp.Diagnostics.Append(waitForTasks(ctx, r.client, tasks)...)
+}
+
+func (r *indexPerformanceSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import UID and save to index_uid attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("index_uid"), req, resp)
+}
+
+// applyPerformanceSettings sends the settings set in the configuration that
+// differ from the state, or all of them when there is no state yet, waits for
+// the resulting tasks and reads back every setting.
+func (r *indexPerformanceSettingsResource) applyPerformanceSettings(ctx context.Context, plan, config, state *indexPerformanceSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	index := r.client.Index(plan.IndexUID.ValueString())
+
+	var updates []func() (*meilisearch.TaskInfo, error)
+
+	if !config.SearchCutoffMs.IsNull() && (state == nil || !plan.SearchCutoffMs.Equal(state.SearchCutoffMs)) {
+		updates = append(updates, func() (*meilisearch.TaskInfo, error) {
+			return index.UpdateSearchCutoffMsWithContext(ctx, plan.SearchCutoffMs.ValueInt64())
+		})
+	}
+
+	if !config.FacetSearch.IsNull() && (state == nil || !plan.FacetSearch.Equal(state.FacetSearch)) {
+		updates = append(updates, func() (*meilisearch.TaskInfo, error) {
+			return index.UpdateFacetSearchWithContext(ctx, plan.FacetSearch.ValueBool())
+		})
+	}
+
+	if !config.PrefixSearch.IsNull() && (state == nil || !plan.PrefixSearch.Equal(state.PrefixSearch)) {
+		updates = append(updates, func() (*meilisearch.TaskInfo, error) {
+			return index.UpdatePrefixSearchWithContext(ctx, plan.PrefixSearch.ValueString())
+		})
+	}
+
+	var tasks []meilisearch.TaskInfo
+
+	for _, update := range updates {
+		task, err := update()
+		if err != nil {
+			diags.AddError(
+				"Error Updating Meilisearch Index Performance Settings",
+				"Could not update index performance settings, unexpected error: "+apierror.Describe(err),
+			)
+			return diags
+		}
+
+		tasks = append(tasks, *task)
+	}
+
+	diags.Append(waitForTasks(ctx, r.client, tasks)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Settings left out of the configuration are only known once read back
+	current := *plan
+
+	found, d := r.readPerformanceSettings(ctx, &current)
+	diags.Append(d...)
+
+	if !found {
+		diags.AddError(
+			"Error Reading Meilisearch Index Performance Settings",
+			"Index "+plan.IndexUID.ValueString()+" was not found after updating its settings.",
+		)
+		return diags
+	}
+
+	if plan.SearchCutoffMs.IsUnknown() {
+		plan.SearchCutoffMs = current.SearchCutoffMs
+	}
+
+	if plan.FacetSearch.IsUnknown() {
+		plan.FacetSearch = current.FacetSearch
+	}
+
+	if plan.PrefixSearch.IsUnknown() {
+		plan.PrefixSearch = current.PrefixSearch
+	}
+
+	plan.ID = current.ID
+
+	return diags
+}
+
+// readPerformanceSettings sets the model from the settings read from
+// Meilisearch. It reports whether the index was found.
+func (r *indexPerformanceSettingsResource) readPerformanceSettings(ctx context.Context, model *indexPerformanceSettingsResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	index := r.client.Index(model.IndexUID.ValueString())
+
+	readError := func(err error) (bool, diag.Diagnostics) {
+		diags.AddError(
+			"Error Reading Meilisearch Index Performance Settings",
+			"Could not read performance settings of Meilisearch index "+model.IndexUID.ValueString()+": "+apierror.Describe(err),
+		)
+		return false, diags
+	}
+
+	// Only the first setting tells whether the index exists
+	searchCutoffMs, err := index.GetSearchCutoffMsWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			return false, diags
+		}
+
+		return readError(err)
+	}
+
+	// Meilisearch returns null, read as 0, when no cutoff is set
+	if searchCutoffMs == 0 {
+		model.SearchCutoffMs = types.Int64Null()
+	} else {
+		model.SearchCutoffMs = types.Int64Value(searchCutoffMs)
+	}
+
+	model.ID = types.StringValue("placeholder")
+
+	// Facet search and prefix search are left null on servers not supporting
+	// them, which answer not found once the index is known to exist
+	model.FacetSearch = types.BoolNull()
+	model.PrefixSearch = types.StringNull()
+
+	if r.providerData.olderThan(facetAndPrefixSearchMinVersion) {
+		return true, diags
+	}
+
+	facetSearch, err := index.GetFacetSearchWithContext(ctx)
+	if err != nil && apierror.StatusCode(err) != http.StatusNotFound {
+		return readError(err)
+	}
+
+	if err == nil {
+		model.FacetSearch = types.BoolValue(facetSearch)
+	}
+
+	prefixSearch, err := index.GetPrefixSearchWithContext(ctx)
+	if err != nil && apierror.StatusCode(err) != http.StatusNotFound {
+		return readError(err)
+	}
+
+	if err == nil && prefixSearch != nil {
+		model.PrefixSearch = types.StringValue(*prefixSearch)
+	}
+
+	return true, diags
+}
+
+// managedSettings returns the names of the settings set in the configuration.
+func (m *indexPerformanceSettingsResourceModel) managedSettings() []string {
+	var managed []string
+
+	if !m.SearchCutoffMs.IsNull() {
+		managed = append(managed, "search_cutoff_ms")
+	}
+
+	if !m.FacetSearch.IsNull() {
+		managed = append(managed, "facet_search")
+	}
+
+	if !m.PrefixSearch.IsNull() {
+		managed = append(managed, "prefix_search")
+	}
+
+	return managed
+}
+
+func getManagedSettings(ctx context.Context, private privateState) ([]string, diag.Diagnostics) {
+	var managed []string
+
+	raw, diags := private.GetKey(ctx, managedSettingsPrivateKey)
+	if diags.HasError() || raw == nil {
+		return managed, diags
+	}
+
+	if err := json.Unmarshal(raw, &managed); err != nil {
+		diags.AddError(
+			"Error Reading Private State",
+			"Could not decode the settings managed by the resource: "+err.Error(),
+		)
+	}
+
+	return managed, diags
+}
+
+func setManagedSettings(ctx context.Context, private privateState, managed []string) diag.Diagnostics {
+	if managed == nil {
+		managed = []string{}
+	}
+
+	raw, err := json.Marshal(managed)
+	if err != nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Error Writing Private State", err.Error())}
+	}
+
+	return private.SetKey(ctx, managedSettingsPrivateKey, raw)
+}
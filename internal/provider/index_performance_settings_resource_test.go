@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+func TestAccIndexPerformanceSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "performance-settings-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_performance_settings" "test" {
+	index_uid = meilisearch_index.test.uid
+	search_cutoff_ms = 150
+	prefix_search = "disabled"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_performance_settings.test", "index_uid", "performance-settings-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_index_performance_settings.test", "search_cutoff_ms", "150"),
+					resource.TestCheckResourceAttr("meilisearch_index_performance_settings.test", "prefix_search", "disabled"),
+					resource.TestCheckResourceAttr("meilisearch_index_performance_settings.test", "facet_search", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "meilisearch_index_performance_settings.test",
+				ImportStateId:                        "performance-settings-index-uid",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index_uid",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "performance-settings-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_performance_settings" "test" {
+	index_uid = meilisearch_index.test.uid
+	facet_search = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_performance_settings.test", "facet_search", "false"),
+					// Settings removed from the configuration are left untouched
+					resource.TestCheckResourceAttr("meilisearch_index_performance_settings.test", "search_cutoff_ms", "150"),
+					resource.TestCheckResourceAttr("meilisearch_index_performance_settings.test", "prefix_search", "disabled"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestPerformanceSettingsManagedSettings(t *testing.T) {
+	model := indexPerformanceSettingsResourceModel{
+		SearchCutoffMs: types.Int64Value(150),
+		FacetSearch:    types.BoolNull(),
+		PrefixSearch:   types.StringValue("disabled"),
+	}
+
+	if managed, expected := model.managedSettings(), []string{"search_cutoff_ms", "prefix_search"}; !reflect.DeepEqual(managed, expected) {
+		t.Errorf("expected %v, got %v", expected, managed)
+	}
+
+	model = indexPerformanceSettingsResourceModel{
+		SearchCutoffMs: types.Int64Null(),
+		FacetSearch:    types.BoolNull(),
+		PrefixSearch:   types.StringNull(),
+	}
+
+	if managed := model.managedSettings(); len(managed) != 0 {
+		t.Errorf("expected no managed settings, got %v", managed)
+	}
+}
+
+func TestReadPerformanceSettings(t *testing.T) {
+	testCases := map[string]struct {
+		data                 *providerData
+		supported            bool
+		expectedFacetQueries int32
+		expectedFacetSearch  types.Bool
+		expectedPrefixSearch types.String
+	}{
+		"older server": {
+			data:                 &providerData{version: serverVersion{major: 1, minor: 11}, rawVersion: "1.11.3"},
+			expectedFacetSearch:  types.BoolNull(),
+			expectedPrefixSearch: types.StringNull(),
+		},
+		"unknown version of an older server": {
+			data:                 &providerData{versionErr: errors.New("forbidden")},
+			expectedFacetQueries: 2,
+			expectedFacetSearch:  types.BoolNull(),
+			expectedPrefixSearch: types.StringNull(),
+		},
+		"recent server": {
+			data:                 &providerData{version: serverVersion{major: 1, minor: 12}, rawVersion: "1.12.0"},
+			supported:            true,
+			expectedFacetQueries: 2,
+			expectedFacetSearch:  types.BoolValue(false),
+			expectedPrefixSearch: types.StringValue("disabled"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var facetQueries atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch {
+				case r.URL.Path == "/indexes/movies/settings/search-cutoff-ms":
+					_, _ = w.Write([]byte(`150`))
+				case strings.HasSuffix(r.URL.Path, "/facet-search") || strings.HasSuffix(r.URL.Path, "/prefix-search"):
+					facetQueries.Add(1)
+
+					// Older servers do not know these routes
+					if !testCase.supported {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+
+					if strings.HasSuffix(r.URL.Path, "/facet-search") {
+						_, _ = w.Write([]byte(`false`))
+					} else {
+						_, _ = w.Write([]byte(`"disabled"`))
+					}
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			t.Cleanup(server.Close)
+
+			client := meilisearch.New(server.URL, meilisearch.WithAPIKey("key"), meilisearch.DisableRetries())
+			testCase.data.client = client
+
+			r := &indexPerformanceSettingsResource{client: client, providerData: testCase.data}
+			model := indexPerformanceSettingsResourceModel{IndexUID: types.StringValue("movies")}
+
+			found, diags := r.readPerformanceSettings(context.Background(), &model)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !found {
+				t.Fatal("expected the settings to be found")
+			}
+
+			if !model.SearchCutoffMs.Equal(types.Int64Value(150)) {
+				t.Errorf("unexpected search_cutoff_ms %s", model.SearchCutoffMs)
+			}
+
+			if !model.FacetSearch.Equal(testCase.expectedFacetSearch) {
+				t.Errorf("expected facet_search %s, got %s", testCase.expectedFacetSearch, model.FacetSearch)
+			}
+
+			if !model.PrefixSearch.Equal(testCase.expectedPrefixSearch) {
+				t.Errorf("expected prefix_search %s, got %s", testCase.expectedPrefixSearch, model.PrefixSearch)
+			}
+
+			if got := facetQueries.Load(); got != testCase.expectedFacetQueries {
+				t.Errorf("expected %d facet and prefix search queries, got %d", testCase.expectedFacetQueries, got)
+			}
+		})
+	}
+}
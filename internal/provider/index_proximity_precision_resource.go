@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// proximityPrecisionMinVersion is the first Meilisearch version supporting
+// the proximity precision setting.
+var proximityPrecisionMinVersion = serverVersion{major: 1, minor: 6}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &indexProximityPrecisionResource{}
+	_ resource.ResourceWithConfigure   = &indexProximityPrecisionResource{}
+	_ resource.ResourceWithModifyPlan  = &indexProximityPrecisionResource{}
+	_ resource.ResourceWithImportState = &indexProximityPrecisionResource{}
+)
+
+// NewIndexProximityPrecisionResource is a helper function to simplify the provider implementation.
+func NewIndexProximityPrecisionResource() resource.Resource {
+	return &indexProximityPrecisionResource{}
+}
+
+// indexProximityPrecisionResource is the resource implementation.
+type indexProximityPrecisionResource struct {
+	client       meilisearch.ServiceManager
+	providerData *providerData
+}
+
+type indexProximityPrecisionResourceModel struct {
+	IndexUID           types.String   `tfsdk:"index_uid"`
+	ProximityPrecision types.String   `tfsdk:"proximity_precision"`
+	ID                 types.String   `tfsdk:"id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *indexProximityPrecisionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_proximity_precision"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexProximityPrecisionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the precision of the proximity ranking rule of a Meilisearch index " +
+			"(see [official documentation](https://www.meilisearch.com/docs/reference/api/settings#proximity-precision)). " +
+			"Requires Meilisearch 1.6 or later.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"proximity_precision": schema.StringAttribute{
+				Description: "Precision level used when calculating the proximity ranking rule: `byWord` computes the exact distance " +
+					"between query terms, `byAttribute` only checks whether they are in the same attribute, which speeds up indexing. " +
+					"Defaults to `byWord`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(meilisearch.ByWord)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(meilisearch.ByWord), string(meilisearch.ByAttribute)),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexProximityPrecisionResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+	r.providerData = data
+}
+
+// ModifyPlan fails the plan when the server does not support proximity precision.
+func (r *indexProximityPrecisionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(r.providerData.requireServerVersionForPlan(ctx, req, "Proximity precision", proximityPrecisionMinVersion, path.Root("proximity_precision"))...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexProximityPrecisionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexProximityPrecisionResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyProximityPrecision(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexProximityPrecisionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexProximityPrecisionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed proximity precision from Meilisearch
+	proximityPrecision, err := r.client.Index(state.IndexUID.ValueString()).GetProximityPrecisionWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Reading Meilisearch Index Proximity Precision",
+				"Could not read proximity precision of Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+			)
+			return
+		}
+	}
+
+	state.ProximityPrecision = types.StringValue(string(proximityPrecision))
+	state.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexProximityPrecisionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan indexProximityPrecisionResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyProximityPrecision(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexProximityPrecisionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexProximityPrecisionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	task, err := r.client.Index(state.IndexUID.ValueString()).ResetProximityPrecisionWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Resetting Meilisearch Index Proximity Precision",
+			"Could not reset index proximity precision, unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+}
+
+func (r *indexProximityPrecisionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import UID and save to index_uid attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("index_uid"), req, resp)
+}
+
+// applyProximityPrecision sends the planned proximity precision and waits for
+// the resulting task.
+func (r *indexProximityPrecisionResource) applyProximityPrecision(ctx context.Context, plan *indexProximityPrecisionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	proximityPrecision := meilisearch.ProximityPrecisionType(plan.ProximityPrecision.ValueString())
+
+	task, err := r.client.Index(plan.IndexUID.ValueString()).UpdateProximityPrecisionWithContext(ctx, proximityPrecision)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Meilisearch Index Proximity Precision",
+			"Could not update index proximity precision, unexpected error: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(waitForTask(ctx, r.client, task)...)
+
+	plan.ID = types.StringValue("placeholder")
+
+	return diags
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexProximityPrecisionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "proximity-precision-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_proximity_precision" "test" {
+	index_uid = meilisearch_index.test.uid
+	proximity_precision = "byAttribute"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_proximity_precision.test", "index_uid", "proximity-precision-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_index_proximity_precision.test", "proximity_precision", "byAttribute"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "meilisearch_index_proximity_precision.test",
+				ImportStateId:                        "proximity-precision-index-uid",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index_uid",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "proximity-precision-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_proximity_precision" "test" {
+	index_uid = meilisearch_index.test.uid
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_proximity_precision.test", "proximity_precision", "byWord"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// builtinRankingRules are the ranking rules provided by Meilisearch.
+var builtinRankingRules = []string{"words", "typo", "proximity", "attribute", "sort", "exactness"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &indexRankingRulesResource{}
+	_ resource.ResourceWithConfigure   = &indexRankingRulesResource{}
+	_ resource.ResourceWithImportState = &indexRankingRulesResource{}
+	_ resource.ResourceWithModifyPlan  = &indexRankingRulesResource{}
+)
+
+// NewIndexRankingRulesResource is a helper function to simplify the provider implementation.
+func NewIndexRankingRulesResource() resource.Resource {
+	return &indexRankingRulesResource{}
+}
+
+// indexRankingRulesResource is the resource implementation.
+type indexRankingRulesResource struct {
+	client meilisearch.ServiceManager
+}
+
+type indexRankingRulesResourceModel struct {
+	IndexUID     types.String   `tfsdk:"index_uid"`
+	RankingRules types.List     `tfsdk:"ranking_rules"`
+	ID           types.String   `tfsdk:"id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *indexRankingRulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_ranking_rules"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexRankingRulesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the ranking rules of a Meilisearch index " +
+			"(see [official documentation](https://www.meilisearch.com/docs/learn/relevancy/ranking_rules)). " +
+			"Destroying the resource resets the ranking rules to their default value.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ranking_rules": schema.ListAttribute{
+				Description: "Ordered list of ranking rules, either built-in (`words`, `typo`, `proximity`, `attribute`, `sort`, `exactness`) " +
+					"or custom ones sorting on a field, such as `release_date:desc`. Plans warn about custom rules sorting on fields " +
+					"that are not among the sortable attributes currently set on the server.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(rankingRuleValidator{}),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexRankingRulesResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+}
+
+// ModifyPlan warns when custom ranking rules sort on fields that are not
+// sortable. Other resources are not readable at plan time, so this is a best
+// effort check against the sortable attributes currently set on the server,
+// which does not account for sortable attributes changed in the same apply.
+func (r *indexRankingRulesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy, or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan indexRankingRulesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.IndexUID.IsUnknown() || plan.RankingRules.IsUnknown() {
+		return
+	}
+
+	sortableAttributes, err := r.client.Index(plan.IndexUID.ValueString()).GetSortableAttributesWithContext(ctx)
+	if apierror.IsNotFound(err) {
+		// The index is created in the same apply
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check Sortable Attributes",
+			"Could not read the sortable attributes of index "+plan.IndexUID.ValueString()+
+				" to check the custom ranking rules: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	var sortable []string
+	if sortableAttributes != nil {
+		sortable = *sortableAttributes
+	}
+
+	current := "none"
+	if len(sortable) > 0 {
+		current = strings.Join(sortable, ", ")
+	}
+
+	for i, rule := range plan.RankingRules.Elements() {
+		rule, ok := rule.(types.String)
+		if !ok || rule.IsUnknown() {
+			continue
+		}
+
+		field, _, custom := parseCustomRankingRule(rule.ValueString())
+		if custom && !slices.Contains(sortable, field) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("ranking_rules").AtListIndex(i),
+				"Field Not Sortable",
+				fmt.Sprintf("Ranking rule %q sorts on %q, which is not one of the sortable attributes currently set on index %s (%s). "+
+					"This warning can be ignored if the field is made sortable in the same apply.",
+					rule.ValueString(), field, plan.IndexUID.ValueString(), current),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexRankingRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexRankingRulesResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyRankingRules(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexRankingRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexRankingRulesResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed ranking rules from Meilisearch
+	rankingRules, err := r.client.Index(state.IndexUID.ValueString()).GetRankingRulesWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Reading Meilisearch Index Ranking Rules",
+				"Could not read ranking rules of Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+			)
+			return
+		}
+	}
+
+	state.RankingRules, diags = types.ListValueFrom(ctx, types.StringType, nonNilStrings(*rankingRules))
+	resp.Diagnostics.Append(diags...)
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexRankingRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan indexRankingRulesResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyRankingRules(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexRankingRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexRankingRulesResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	task, err := r.client.Index(state.IndexUID.ValueString()).ResetRankingRulesWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Resetting Meilisearch Index Ranking Rules",
+			"Could not reset index ranking rules, unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+}
+
+func (r *indexRankingRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import UID and save to index_uid attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("index_uid"), req, resp)
+}
+
+// applyRankingRules replaces the ranking rules of the index with the planned
+// ones and waits for the resulting task.
+func (r *indexRankingRulesResource) applyRankingRules(ctx context.Context, plan *indexRankingRulesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var rankingRules []string
+
+	diags.Append(plan.RankingRules.ElementsAs(ctx, &rankingRules, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	task, err := r.client.Index(plan.IndexUID.ValueString()).UpdateRankingRulesWithContext(ctx, &rankingRules)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Meilisearch Index Ranking Rules",
+			"Could not update index ranking rules, unexpected error: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(waitForTask(ctx, r.client, task)...)
+
+	plan.ID = types.StringValue("placeholder")
+
+	return diags
+}
+
+// parseCustomRankingRule splits a custom ranking rule such as
+// `release_date:desc` into its field and order.
+func parseCustomRankingRule(rule string) (string, string, bool) {
+	separator := strings.LastIndex(rule, ":")
+	if separator < 1 {
+		return "", "", false
+	}
+
+	field, order := rule[:separator], rule[separator+1:]
+	if order != "asc" && order != "desc" {
+		return "", "", false
+	}
+
+	return field, order, true
+}
+
+// rankingRuleValidator ensures that a ranking rule is either built-in or a
+// custom rule sorting on a field in ascending or descending order.
+type rankingRuleValidator struct{}
+
+func (v rankingRuleValidator) Description(_ context.Context) string {
+	return "value must be a built-in ranking rule or match field:asc or field:desc"
+}
+
+func (v rankingRuleValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a built-in ranking rule or match `field:asc` or `field:desc`"
+}
+
+func (v rankingRuleValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	rule := req.ConfigValue.ValueString()
+
+	if slices.Contains(builtinRankingRules, rule) {
+		return
+	}
+
+	if _, _, custom := parseCustomRankingRule(rule); custom {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Ranking Rule",
+		fmt.Sprintf("%q is neither a built-in ranking rule (%s) nor a custom rule such as field:asc or field:desc.",
+			rule, strings.Join(builtinRankingRules, ", ")),
+	)
+}
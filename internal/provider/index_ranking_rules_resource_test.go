@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+func TestAccIndexRankingRulesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "ranking-rules-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_ranking_rules" "test" {
+	index_uid = meilisearch_index.test.uid
+	ranking_rules = ["words", "typo", "release_date:desc"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_ranking_rules.test", "index_uid", "ranking-rules-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_index_ranking_rules.test", "ranking_rules.#", "3"),
+					resource.TestCheckResourceAttr("meilisearch_index_ranking_rules.test", "ranking_rules.2", "release_date:desc"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "meilisearch_index_ranking_rules.test",
+				ImportStateId:                        "ranking-rules-index-uid",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index_uid",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "ranking-rules-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_ranking_rules" "test" {
+	index_uid = meilisearch_index.test.uid
+	ranking_rules = ["price:asc", "words", "typo", "proximity", "attribute", "sort", "exactness"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_ranking_rules.test", "ranking_rules.#", "7"),
+					resource.TestCheckResourceAttr("meilisearch_index_ranking_rules.test", "ranking_rules.0", "price:asc"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestRankingRuleValidator(t *testing.T) {
+	cases := map[string]bool{
+		"words":             true,
+		"exactness":         true,
+		"release_date:desc": true,
+		"price:asc":         true,
+		"nested.field:asc":  true,
+		"price":             false,
+		"price:up":          false,
+		":asc":              false,
+		"Words":             false,
+		"":                  false,
+	}
+
+	for rule, valid := range cases {
+		t.Run(rule, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("ranking_rules").AtListIndex(0),
+				ConfigValue: types.StringValue(rule),
+			}
+			resp := &validator.StringResponse{}
+
+			rankingRuleValidator{}.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() == valid {
+				t.Errorf("expected %q to be valid: %t, got diagnostics %v", rule, valid, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestIndexRankingRulesModifyPlan(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		status           int
+		body             string
+		expectedWarnings []string
+	}{
+		"sortable": {
+			status: http.StatusOK,
+			body:   `["price"]`,
+		},
+		"not sortable": {
+			status:           http.StatusOK,
+			body:             `["release_date"]`,
+			expectedWarnings: []string{"Field Not Sortable"},
+		},
+		"no sortable attributes": {
+			status:           http.StatusOK,
+			body:             `[]`,
+			expectedWarnings: []string{"Field Not Sortable"},
+		},
+		"index not created yet": {
+			status: http.StatusNotFound,
+			body:   `{"message": "Index ` + "`movies`" + ` not found.", "code": "index_not_found", "type": "invalid_request", "link": ""}`,
+		},
+		"server error": {
+			status:           http.StatusInternalServerError,
+			body:             `{"message": "internal", "code": "internal", "type": "internal", "link": ""}`,
+			expectedWarnings: []string{"Unable to Check Sortable Attributes"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(testCase.status)
+				_, _ = w.Write([]byte(testCase.body))
+			}))
+			t.Cleanup(server.Close)
+
+			r := &indexRankingRulesResource{client: meilisearch.New(server.URL, meilisearch.WithAPIKey("key"), meilisearch.DisableRetries())}
+
+			var schemaResp fwresource.SchemaResponse
+
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+
+			diags := plan.Set(ctx, indexRankingRulesResourceModel{
+				IndexUID:     types.StringValue("movies"),
+				RankingRules: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("words"), types.StringValue("price:asc")}),
+				ID:           types.StringUnknown(),
+				Timeouts:     timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{"create": types.StringType, "update": types.StringType, "delete": types.StringType})},
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var warnings []string
+			for _, warning := range resp.Diagnostics.Warnings() {
+				warnings = append(warnings, warning.Summary())
+			}
+
+			if !slices.Equal(warnings, testCase.expectedWarnings) {
+				t.Errorf("expected warnings %v, got %v", testCase.expectedWarnings, warnings)
+			}
+		})
+	}
+}
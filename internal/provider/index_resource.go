@@ -2,12 +2,15 @@ package provider
 
 import (
 	"context"
-	"strings"
+	"fmt"
+	"terraform-provider-meilisearch/internal/apierror"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,6 +23,7 @@ var (
 	_ resource.Resource                = &indexResource{}
 	_ resource.ResourceWithConfigure   = &indexResource{}
 	_ resource.ResourceWithImportState = &indexResource{}
+	_ resource.ResourceWithModifyPlan  = &indexResource{}
 )
 
 // NewIndexResource is a helper function to simplify the provider implementation.
@@ -33,11 +37,13 @@ type indexResource struct {
 }
 
 type indexResourceModel struct {
-	UID        types.String `tfsdk:"uid"`
-	PrimaryKey types.String `tfsdk:"primary_key"`
-	CreatedAt  types.String `tfsdk:"created_at"`
-	UpdatedAt  types.String `tfsdk:"updated_at"`
-	ID         types.String `tfsdk:"id"`
+	UID                       types.String   `tfsdk:"uid"`
+	PrimaryKey                types.String   `tfsdk:"primary_key"`
+	ReplaceOnPrimaryKeyChange types.Bool     `tfsdk:"replace_on_primary_key_change"`
+	CreatedAt                 types.String   `tfsdk:"created_at"`
+	UpdatedAt                 types.String   `tfsdk:"updated_at"`
+	ID                        types.String   `tfsdk:"id"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -46,7 +52,7 @@ func (r *indexResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 // Schema defines the schema for the resource.
-func (r *indexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *indexResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Meilisearch Index.",
 		Attributes: map[string]schema.Attribute{
@@ -59,11 +65,20 @@ func (r *indexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"primary_key": schema.StringAttribute{
 				Description: "Primary key of the index (`null` if not specified and if no documents have been added yet, see [official documentation](https://www.meilisearch.com/docs/learn/core_concepts/primary_key#meilisearch-guesses-your-primary-key) for more details).",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"replace_on_primary_key_change": schema.BoolAttribute{
+				Description: "Whether to replace the index when `primary_key` changes while the index contains documents. " +
+					"Meilisearch only accepts primary key updates on empty indexes, so the change fails at plan time unless this is enabled. " +
+					"Replacing the index deletes all of its documents. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"created_at": schema.StringAttribute{
 				Description: "Date and time when the key was created (RFC3339)",
 				Computed:    true,
@@ -77,6 +92,13 @@ func (r *indexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -86,13 +108,14 @@ func (r *indexResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	var ok bool
-
-	r.client, ok = req.ProviderData.(meilisearch.ServiceManager)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
 	}
+
+	r.client = data.client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -107,47 +130,49 @@ func (r *indexResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createIndexConfig := meilisearch.IndexConfig{
 		Uid:        plan.UID.ValueString(),
 		PrimaryKey: plan.PrimaryKey.ValueString(),
 	}
-	task, err := r.client.CreateIndex(&createIndexConfig)
+	task, err := r.client.CreateIndexWithContext(ctx, &createIndexConfig)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating index",
-			"Could not create index, unexpected error: "+err.Error(),
+			"Could not create index, unexpected error: "+apierror.Describe(err),
 		)
 		return
 	}
 
-	waitTask, err := r.client.WaitForTask(task.TaskUID, time.Duration(5)*time.Second)
+	resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	index, err := r.client.GetIndexWithContext(ctx, createIndexConfig.Uid)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error fetching index creation task",
-			"unexpected error: "+err.Error(),
+			"Error fetching index data",
+			"unexpected error: "+apierror.Describe(err),
 		)
 		return
 	}
 
-	if waitTask.Status == "succeeded" {
-		index, err := r.client.GetIndex(createIndexConfig.Uid)
-
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error fetching index data",
-				"unexpected error: "+err.Error(),
-			)
-			return
-		}
-
-		plan.UID = types.StringValue(index.UID)
-		plan.PrimaryKey = types.StringValue(index.PrimaryKey)
-		plan.CreatedAt = types.StringValue(index.CreatedAt.Format(time.RFC3339))
-		plan.UpdatedAt = types.StringValue(index.UpdatedAt.Format(time.RFC3339))
-
-	}
+	plan.UID = types.StringValue(index.UID)
+	plan.PrimaryKey = stringValueOrNull(index.PrimaryKey)
+	plan.CreatedAt = types.StringValue(index.CreatedAt.Format(time.RFC3339))
+	plan.UpdatedAt = types.StringValue(index.UpdatedAt.Format(time.RFC3339))
 
 	plan.ID = types.StringValue("placeholder")
 
@@ -172,15 +197,15 @@ func (r *indexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Get refreshed index value from Meilisearch
-	index, err := r.client.GetIndex(state.UID.ValueString())
+	index, err := r.client.GetIndexWithContext(ctx, state.UID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "index_not_found,") {
+		if apierror.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		} else {
 			resp.Diagnostics.AddError(
 				"Error Reading Meilisearch Index",
-				"Could not read Meilisearch index ID "+state.UID.ValueString()+": "+err.Error(),
+				"Could not read Meilisearch index ID "+state.UID.ValueString()+": "+apierror.Describe(err),
 			)
 			return
 		}
@@ -188,10 +213,16 @@ func (r *indexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Overwrite items with refreshed state
 	indexState := indexResourceModel{
-		UID:        types.StringValue(index.UID),
-		PrimaryKey: types.StringValue(index.PrimaryKey),
-		CreatedAt:  types.StringValue(index.CreatedAt.Format(time.RFC3339)),
-		UpdatedAt:  types.StringValue(index.UpdatedAt.Format(time.RFC3339)),
+		UID:                       types.StringValue(index.UID),
+		PrimaryKey:                stringValueOrNull(index.PrimaryKey),
+		ReplaceOnPrimaryKeyChange: state.ReplaceOnPrimaryKeyChange,
+		CreatedAt:                 types.StringValue(index.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:                 types.StringValue(index.UpdatedAt.Format(time.RFC3339)),
+		Timeouts:                  state.Timeouts,
+	}
+
+	if indexState.ReplaceOnPrimaryKeyChange.IsNull() {
+		indexState.ReplaceOnPrimaryKeyChange = types.BoolValue(false)
 	}
 
 	state = indexState
@@ -208,7 +239,71 @@ func (r *indexResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *indexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Not implemented for now, no attributes can be changed
+	// Retrieve values from plan and state
+	var plan, state indexResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update primary key, only accepted by Meilisearch while the index is empty
+	if !plan.PrimaryKey.IsUnknown() && !plan.PrimaryKey.IsNull() && !plan.PrimaryKey.Equal(state.PrimaryKey) {
+		task, err := r.client.Index(plan.UID.ValueString()).UpdateIndexWithContext(ctx, plan.PrimaryKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Meilisearch Index",
+				"Could not update index primary key, unexpected error: "+apierror.Describe(err),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	index, err := r.client.GetIndexWithContext(ctx, plan.UID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching index data",
+			"unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	plan.PrimaryKey = stringValueOrNull(index.PrimaryKey)
+	plan.CreatedAt = types.StringValue(index.CreatedAt.Format(time.RFC3339))
+	plan.UpdatedAt = types.StringValue(index.UpdatedAt.Format(time.RFC3339))
+
+	plan.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -222,18 +317,93 @@ func (r *indexResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing index
-	_, err := r.client.DeleteIndex(state.UID.ValueString())
+	task, err := r.client.DeleteIndexWithContext(ctx, state.UID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Meilisearch Index",
-			"Could not delete index, unexpected error: "+err.Error(),
+			"Could not delete index, unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+}
+
+// ModifyPlan checks whether a primary key change can be applied in place.
+// Meilisearch rejects the change once the index contains documents, in which
+// case the index is either replaced, if allowed, or the plan fails.
+func (r *indexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on creation and destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state indexResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.PrimaryKey.IsUnknown() || plan.PrimaryKey.IsNull() || plan.PrimaryKey.Equal(state.PrimaryKey) {
+		return
+	}
+
+	// The index is replaced anyway
+	if !plan.UID.Equal(state.UID) {
+		return
+	}
+
+	stats, err := r.client.Index(state.UID.ValueString()).GetStatsWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Meilisearch Index Stats",
+			"Could not check whether index "+state.UID.ValueString()+" contains documents: "+apierror.Describe(err),
 		)
 		return
 	}
+
+	if stats.NumberOfDocuments == 0 {
+		return
+	}
+
+	if plan.ReplaceOnPrimaryKeyChange.ValueBool() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("primary_key"))
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("primary_key"),
+		"Cannot Update Primary Key of Non-Empty Index",
+		fmt.Sprintf("Index %q contains %d documents and Meilisearch only accepts primary key updates on empty indexes. "+
+			"Either delete the documents of the index first, or set replace_on_primary_key_change to true to destroy and re-create the index, losing all of its documents.",
+			state.UID.ValueString(), stats.NumberOfDocuments),
+	)
 }
 
 func (r *indexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import UID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("uid"), req, resp)
 }
+
+// stringValueOrNull maps the empty strings returned by Meilisearch for unset
+// values to null.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -35,9 +36,10 @@ resource "meilisearch_index" "test" {
 	primary_key = "updated-index-primary-key"
 }
 `,
+				// Primary key of an empty index is updated in place
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction("meilisearch_index.test", "Replace"),
+						plancheck.ExpectResourceAction("meilisearch_index.test", "Update"),
 					},
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -70,3 +72,107 @@ resource "meilisearch_index" "test" {
 		},
 	})
 }
+
+func TestAccIndexResourceNoPrimaryKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Primary key is left for Meilisearch to infer
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "index-no-primary-key-uid"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index.test", "uid", "index-no-primary-key-uid"),
+					resource.TestCheckNoResourceAttr("meilisearch_index.test", "primary_key"),
+					resource.TestCheckResourceAttr("meilisearch_index.test", "replace_on_primary_key_change", "false"),
+				),
+			},
+			// Primary key is set afterwards without replacing the index
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "index-no-primary-key-uid"
+	primary_key = "id"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("meilisearch_index.test", "Update"),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index.test", "primary_key", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIndexResourcePrimaryKeyChange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Index containing documents
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "index-primary-key-change-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_documents" "test" {
+	index_uid = meilisearch_index.test.uid
+	primary_key = "id"
+	documents = [
+		jsonencode({ id = 1, ref = "carol", title = "Carol" }),
+	]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index.test", "primary_key", "id"),
+					resource.TestCheckResourceAttr("meilisearch_index.test", "replace_on_primary_key_change", "false"),
+				),
+			},
+			// Primary key of a non-empty index cannot be updated in place
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "index-primary-key-change-uid"
+	primary_key = "ref"
+}
+
+resource "meilisearch_documents" "test" {
+	index_uid = meilisearch_index.test.uid
+	primary_key = "id"
+	documents = [
+		jsonencode({ id = 1, ref = "carol", title = "Carol" }),
+	]
+}
+`,
+				ExpectError: regexp.MustCompile("Cannot Update Primary Key of Non-Empty Index"),
+			},
+			// Index is replaced once allowed
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "index-primary-key-change-uid"
+	primary_key = "ref"
+	replace_on_primary_key_change = true
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("meilisearch_index.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index.test", "primary_key", "ref"),
+					resource.TestCheckResourceAttr("meilisearch_index.test", "replace_on_primary_key_change", "true"),
+				),
+			},
+		},
+	})
+}
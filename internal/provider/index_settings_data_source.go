@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &indexSettingsDataSource{}
+	_ datasource.DataSourceWithConfigure = &indexSettingsDataSource{}
+)
+
+func NewIndexSettingsDataSource() datasource.DataSource {
+	return &indexSettingsDataSource{}
+}
+
+// indexSettingsDataSource defines the data source implementation.
+type indexSettingsDataSource struct {
+	client meilisearch.ServiceManager
+}
+
+type indexSettingsDataSourceModel struct {
+	IndexUID             types.String             `tfsdk:"index_uid"`
+	RankingRules         types.List               `tfsdk:"ranking_rules"`
+	DistinctAttribute    types.String             `tfsdk:"distinct_attribute"`
+	SearchableAttributes types.List               `tfsdk:"searchable_attributes"`
+	DisplayedAttributes  types.List               `tfsdk:"displayed_attributes"`
+	FilterableAttributes types.Set                `tfsdk:"filterable_attributes"`
+	SortableAttributes   types.Set                `tfsdk:"sortable_attributes"`
+	StopWords            types.Set                `tfsdk:"stop_words"`
+	Synonyms             types.Map                `tfsdk:"synonyms"`
+	Dictionary           types.Set                `tfsdk:"dictionary"`
+	SeparatorTokens      types.Set                `tfsdk:"separator_tokens"`
+	NonSeparatorTokens   types.Set                `tfsdk:"non_separator_tokens"`
+	ProximityPrecision   types.String             `tfsdk:"proximity_precision"`
+	SearchCutoffMs       types.Int64              `tfsdk:"search_cutoff_ms"`
+	FacetSearch          types.Bool               `tfsdk:"facet_search"`
+	PrefixSearch         types.String             `tfsdk:"prefix_search"`
+	TypoTolerance        types.Object             `tfsdk:"typo_tolerance"`
+	Pagination           types.Object             `tfsdk:"pagination"`
+	Faceting             types.Object             `tfsdk:"faceting"`
+	LocalizedAttributes  types.List               `tfsdk:"localized_attributes"`
+	Embedders            map[string]embedderModel `tfsdk:"embedders"`
+	ID                   types.String             `tfsdk:"id"`
+}
+
+func (d *indexSettingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_settings"
+}
+
+func (d *indexSettingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the settings of a Meilisearch index, including indexes not managed by Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+			},
+			"ranking_rules": schema.ListAttribute{
+				Description: "Ordered list of ranking rules.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"distinct_attribute": schema.StringAttribute{
+				Description: "Attribute used to deduplicate search results.",
+				Computed:    true,
+			},
+			"searchable_attributes": schema.ListAttribute{
+				Description: "Attributes whose values are searched, in order of importance.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"displayed_attributes": schema.ListAttribute{
+				Description: "Attributes displayed in the returned documents.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"filterable_attributes": schema.SetAttribute{
+				Description: "Attributes that can be used as filters and facets.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"sortable_attributes": schema.SetAttribute{
+				Description: "Attributes that can be used when sorting search results.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"stop_words": schema.SetAttribute{
+				Description: "Words ignored in search queries.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"synonyms": schema.MapAttribute{
+				Description: "Words considered equivalent in search queries, by word.",
+				ElementType: types.SetType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			"dictionary": schema.SetAttribute{
+				Description: "Words indexed and searched as single terms.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"separator_tokens": schema.SetAttribute{
+				Description: "Tokens considered as word separators in addition to the default ones.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"non_separator_tokens": schema.SetAttribute{
+				Description: "Tokens no longer considered as word separators.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"proximity_precision": schema.StringAttribute{
+				Description: "Precision level used when calculating the proximity ranking rule (`byWord` or `byAttribute`).",
+				Computed:    true,
+			},
+			"search_cutoff_ms": schema.Int64Attribute{
+				Description: "Maximum duration of a search query, in milliseconds (`null` when not set).",
+				Computed:    true,
+			},
+			"facet_search": schema.BoolAttribute{
+				Description: "Whether facet search is enabled.",
+				Computed:    true,
+			},
+			"prefix_search": schema.StringAttribute{
+				Description: "When prefix search is computed (`indexingTime` or `disabled`).",
+				Computed:    true,
+			},
+			"typo_tolerance": schema.SingleNestedAttribute{
+				Description: "Typo tolerance settings.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Whether typo tolerance is enabled.",
+						Computed:    true,
+					},
+					"min_word_size_for_typos": schema.SingleNestedAttribute{
+						Description: "Minimum word sizes for accepting typos.",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"one_typo": schema.Int64Attribute{
+								Description: "Minimum word size for accepting 1 typo.",
+								Computed:    true,
+							},
+							"two_typos": schema.Int64Attribute{
+								Description: "Minimum word size for accepting 2 typos.",
+								Computed:    true,
+							},
+						},
+					},
+					"disable_on_words": schema.SetAttribute{
+						Description: "Words for which typo tolerance is disabled.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"disable_on_attributes": schema.SetAttribute{
+						Description: "Attributes for which typo tolerance is disabled.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+			"pagination": schema.SingleNestedAttribute{
+				Description: "Pagination settings.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"max_total_hits": schema.Int64Attribute{
+						Description: "Maximum number of search results returned for a query.",
+						Computed:    true,
+					},
+				},
+			},
+			"faceting": schema.SingleNestedAttribute{
+				Description: "Faceting settings.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"max_values_per_facet": schema.Int64Attribute{
+						Description: "Maximum number of values returned for each facet.",
+						Computed:    true,
+					},
+					"sort_facet_values_by": schema.MapAttribute{
+						Description: "Sort order of facet values (`alpha` or `count`) by attribute name, `*` matching all attributes.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+			"localized_attributes": schema.ListNestedAttribute{
+				Description: "Locales used for the attributes matching the given patterns.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute_patterns": schema.ListAttribute{
+							Description: "Patterns of the attributes the locales apply to.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"locales": schema.ListAttribute{
+							Description: "Locales of the matching attributes.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"embedders": schema.MapNestedAttribute{
+				Description: "Embedders of the index, by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Description: "Source of the embeddings, one of `openAi`, `huggingFace`, `ollama`, `rest` or `userProvided`.",
+							Computed:    true,
+						},
+						"model": schema.StringAttribute{
+							Description: "Model generating the embeddings.",
+							Computed:    true,
+						},
+						"api_key": schema.StringAttribute{
+							Description: "Always `null`, as Meilisearch only returns API keys of embedders masked.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"document_template": schema.StringAttribute{
+							Description: "Liquid template used to turn a document into the text to embed.",
+							Computed:    true,
+						},
+						"dimensions": schema.Int64Attribute{
+							Description: "Number of dimensions of the embeddings.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "URL of the embedder.",
+							Computed:    true,
+						},
+						"revision": schema.StringAttribute{
+							Description: "Revision (commit) of the model.",
+							Computed:    true,
+						},
+						"request": schema.StringAttribute{
+							Description: "JSON template of the requests sent to the embedder.",
+							Computed:    true,
+						},
+						"response": schema.StringAttribute{
+							Description: "JSON template of the responses of the embedder.",
+							Computed:    true,
+						},
+						"headers": schema.MapAttribute{
+							Description: "Additional headers sent with each request to the embedder.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"distribution": schema.SingleNestedAttribute{
+							Description: "Distribution of the semantic scores, used to correct the relevancy of the embedder.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"mean": schema.Float64Attribute{
+									Description: "Mean of the distribution.",
+									Computed:    true,
+								},
+								"sigma": schema.Float64Attribute{
+									Description: "Standard deviation of the distribution.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *indexSettingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state indexSettingsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := d.client.Index(state.IndexUID.ValueString()).GetSettingsWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Meilisearch Index Settings",
+			apierror.Describe(err),
+		)
+		return
+	}
+
+	// Settings shared with the resource are mapped the same way
+	var model indexSettingsResourceModel
+
+	resp.Diagnostics.Append(flattenIndexSettings(ctx, settings, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.RankingRules = model.RankingRules
+	state.DistinctAttribute = model.DistinctAttribute
+	state.SearchableAttributes = model.SearchableAttributes
+	state.DisplayedAttributes = model.DisplayedAttributes
+	state.FilterableAttributes = model.FilterableAttributes
+	state.SortableAttributes = model.SortableAttributes
+	state.StopWords = model.StopWords
+	state.Synonyms = model.Synonyms
+	state.Dictionary = model.Dictionary
+	state.SeparatorTokens = model.SeparatorTokens
+	state.NonSeparatorTokens = model.NonSeparatorTokens
+	state.ProximityPrecision = model.ProximityPrecision
+	state.SearchCutoffMs = model.SearchCutoffMs
+	state.TypoTolerance = model.TypoTolerance
+	state.Pagination = model.Pagination
+	state.Faceting = model.Faceting
+	state.LocalizedAttributes = model.LocalizedAttributes
+
+	// Embedders are mapped as imported by the embedders resource
+	var embedders indexEmbeddersResourceModel
+
+	resp.Diagnostics.Append(flattenEmbedders(ctx, settings.Embedders, &embedders)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Embedders = embedders.Embedders
+
+	state.FacetSearch = types.BoolValue(settings.FacetSearch)
+	state.PrefixSearch = types.StringPointerValue(settings.PrefixSearch)
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *indexSettingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the data source")
+		return
+	}
+
+	d.client = data.client
+}
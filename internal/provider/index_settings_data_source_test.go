@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+func TestAccIndexSettingsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "meilisearch_index_settings" "test" {
+	index_uid = "test_index"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meilisearch_index_settings.test", "index_uid", "test_index"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_index_settings.test", "ranking_rules.#"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_index_settings.test", "filterable_attributes.#"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_index_settings.test", "sortable_attributes.#"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_index_settings.test", "typo_tolerance.enabled"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_index_settings.test", "pagination.max_total_hits"),
+					// Verify ID placeholder attribute is set
+					resource.TestCheckResourceAttr("data.meilisearch_index_settings.test", "id", "placeholder"),
+				),
+			},
+		},
+	})
+}
+
+func TestIndexSettingsDataSourceEmbedders(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/indexes/movies/settings" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"rankingRules": ["words"],
+			"embedders": {
+				"default": {
+					"source": "openAi",
+					"model": "text-embedding-3-small",
+					"apiKey": "sk-X...",
+					"documentTemplate": "{{doc.title}}",
+					"dimensions": 512
+				}
+			}
+		}`))
+	}))
+	t.Cleanup(server.Close)
+
+	d := &indexSettingsDataSource{client: meilisearch.New(server.URL, meilisearch.WithAPIKey("key"), meilisearch.DisableRetries())}
+
+	var schemaResp datasource.SchemaResponse
+
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+	diags := state.SetAttribute(ctx, path.Root("index_uid"), types.StringValue("movies"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var model indexSettingsDataSourceModel
+
+	if diags := resp.State.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	embedder, ok := model.Embedders["default"]
+	if !ok {
+		t.Fatalf("expected the default embedder, got %v", model.Embedders)
+	}
+
+	if !embedder.Source.Equal(types.StringValue("openAi")) || !embedder.Model.Equal(types.StringValue("text-embedding-3-small")) {
+		t.Errorf("unexpected source %s and model %s", embedder.Source, embedder.Model)
+	}
+
+	if !embedder.Dimensions.Equal(types.Int64Value(512)) {
+		t.Errorf("expected 512 dimensions, got %s", embedder.Dimensions)
+	}
+
+	if !embedder.APIKey.IsNull() {
+		t.Errorf("expected a null api_key, got %s", embedder.APIKey)
+	}
+}
@@ -0,0 +1,1048 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &indexSettingsResource{}
+	_ resource.ResourceWithConfigure      = &indexSettingsResource{}
+	_ resource.ResourceWithModifyPlan     = &indexSettingsResource{}
+	_ resource.ResourceWithImportState    = &indexSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &indexSettingsResource{}
+)
+
+// NewIndexSettingsResource is a helper function to simplify the provider implementation.
+func NewIndexSettingsResource() resource.Resource {
+	return &indexSettingsResource{}
+}
+
+// indexSettingsResource is the resource implementation.
+type indexSettingsResource struct {
+	client       meilisearch.ServiceManager
+	providerData *providerData
+}
+
+type indexSettingsResourceModel struct {
+	IndexUID             types.String   `tfsdk:"index_uid"`
+	RankingRules         types.List     `tfsdk:"ranking_rules"`
+	DistinctAttribute    types.String   `tfsdk:"distinct_attribute"`
+	SearchableAttributes types.List     `tfsdk:"searchable_attributes"`
+	DisplayedAttributes  types.List     `tfsdk:"displayed_attributes"`
+	FilterableAttributes types.Set      `tfsdk:"filterable_attributes"`
+	SortableAttributes   types.Set      `tfsdk:"sortable_attributes"`
+	StopWords            types.Set      `tfsdk:"stop_words"`
+	Synonyms             types.Map      `tfsdk:"synonyms"`
+	Dictionary           types.Set      `tfsdk:"dictionary"`
+	SeparatorTokens      types.Set      `tfsdk:"separator_tokens"`
+	NonSeparatorTokens   types.Set      `tfsdk:"non_separator_tokens"`
+	ProximityPrecision   types.String   `tfsdk:"proximity_precision"`
+	SearchCutoffMs       types.Int64    `tfsdk:"search_cutoff_ms"`
+	TypoTolerance        types.Object   `tfsdk:"typo_tolerance"`
+	Pagination           types.Object   `tfsdk:"pagination"`
+	Faceting             types.Object   `tfsdk:"faceting"`
+	LocalizedAttributes  types.List     `tfsdk:"localized_attributes"`
+	ID                   types.String   `tfsdk:"id"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+type typoToleranceModel struct {
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	MinWordSizeForTypos types.Object `tfsdk:"min_word_size_for_typos"`
+	DisableOnWords      types.Set    `tfsdk:"disable_on_words"`
+	DisableOnAttributes types.Set    `tfsdk:"disable_on_attributes"`
+}
+
+type minWordSizeForTyposModel struct {
+	OneTypo  types.Int64 `tfsdk:"one_typo"`
+	TwoTypos types.Int64 `tfsdk:"two_typos"`
+}
+
+type paginationModel struct {
+	MaxTotalHits types.Int64 `tfsdk:"max_total_hits"`
+}
+
+type facetingModel struct {
+	MaxValuesPerFacet types.Int64 `tfsdk:"max_values_per_facet"`
+	SortFacetValuesBy types.Map   `tfsdk:"sort_facet_values_by"`
+}
+
+type localizedAttributeModel struct {
+	AttributePatterns types.List `tfsdk:"attribute_patterns"`
+	Locales           types.List `tfsdk:"locales"`
+}
+
+var (
+	minWordSizeForTyposAttrTypes = map[string]attr.Type{
+		"one_typo":  types.Int64Type,
+		"two_typos": types.Int64Type,
+	}
+
+	typoToleranceAttrTypes = map[string]attr.Type{
+		"enabled":                 types.BoolType,
+		"min_word_size_for_typos": types.ObjectType{AttrTypes: minWordSizeForTyposAttrTypes},
+		"disable_on_words":        types.SetType{ElemType: types.StringType},
+		"disable_on_attributes":   types.SetType{ElemType: types.StringType},
+	}
+
+	paginationAttrTypes = map[string]attr.Type{
+		"max_total_hits": types.Int64Type,
+	}
+
+	facetingAttrTypes = map[string]attr.Type{
+		"max_values_per_facet": types.Int64Type,
+		"sort_facet_values_by": types.MapType{ElemType: types.StringType},
+	}
+
+	localizedAttributeAttrTypes = map[string]attr.Type{
+		"attribute_patterns": types.ListType{ElemType: types.StringType},
+		"locales":            types.ListType{ElemType: types.StringType},
+	}
+)
+
+// Metadata returns the resource type name.
+func (r *indexSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexSettingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of a Meilisearch index. Settings omitted from the configuration are left untouched and read back from Meilisearch. " +
+			"Destroying the resource resets every setting of the index to its default value. " +
+			"Embedders are not managed by this resource and are ignored, since Meilisearch returns their API keys masked: " +
+			"use `meilisearch_index_embedders` to manage them, keeping in mind that destroying this resource also removes them.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ranking_rules": schema.ListAttribute{
+				Description: "Ordered list of ranking rules. When `sortable_attributes` is also set, custom `field:asc` and `field:desc` rules must sort on one of its attributes.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(rankingRuleValidator{}),
+				},
+			},
+			"distinct_attribute": schema.StringAttribute{
+				Description: "Attribute used to deduplicate search results.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"searchable_attributes": schema.ListAttribute{
+				Description: "Attributes whose values are searched, in order of importance.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"displayed_attributes": schema.ListAttribute{
+				Description: "Attributes displayed in the returned documents.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"filterable_attributes": schema.SetAttribute{
+				Description: "Attributes that can be used as filters and facets.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sortable_attributes": schema.SetAttribute{
+				Description: "Attributes that can be used when sorting search results.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stop_words": schema.SetAttribute{
+				Description: "Words ignored in search queries.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"synonyms": schema.MapAttribute{
+				Description: "Map of words to the list of their synonyms.",
+				ElementType: types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dictionary": schema.SetAttribute{
+				Description: "Strings that Meilisearch should parse as single terms.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"separator_tokens": schema.SetAttribute{
+				Description: "Strings that Meilisearch should consider as word separators.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"non_separator_tokens": schema.SetAttribute{
+				Description: "Strings that Meilisearch should not consider as word separators.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"proximity_precision": schema.StringAttribute{
+				Description: "Precision level used when calculating the proximity ranking rule (`byWord` or `byAttribute`). Requires Meilisearch 1.6 or later.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(meilisearch.ByWord), string(meilisearch.ByAttribute)),
+				},
+			},
+			"search_cutoff_ms": schema.Int64Attribute{
+				Description: "Maximum duration of a search query, in milliseconds.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"typo_tolerance": schema.SingleNestedAttribute{
+				Description: "Typo tolerance settings.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Whether typo tolerance is enabled.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"min_word_size_for_typos": schema.SingleNestedAttribute{
+						Description: "Minimum word sizes for accepting typos.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.Object{
+							minWordSizeForTyposValidator{},
+						},
+						Attributes: map[string]schema.Attribute{
+							"one_typo": schema.Int64Attribute{
+								Description: "Minimum word size for accepting 1 typo.",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.UseStateForUnknown(),
+								},
+							},
+							"two_typos": schema.Int64Attribute{
+								Description: "Minimum word size for accepting 2 typos.",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.UseStateForUnknown(),
+								},
+							},
+						},
+					},
+					"disable_on_words": schema.SetAttribute{
+						Description: "Words for which typo tolerance is disabled.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"disable_on_attributes": schema.SetAttribute{
+						Description: "Attributes for which typo tolerance is disabled.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"pagination": schema.SingleNestedAttribute{
+				Description: "Pagination settings.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"max_total_hits": schema.Int64Attribute{
+						Description: "Maximum number of results a query can return.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"faceting": schema.SingleNestedAttribute{
+				Description: "Faceting settings.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"max_values_per_facet": schema.Int64Attribute{
+						Description: "Maximum number of values returned for each facet.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"sort_facet_values_by": schema.MapAttribute{
+						Description: "Sort order of facet values (`alpha` or `count`) by attribute name, `*` matching all attributes.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Map{
+							mapplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"localized_attributes": schema.ListNestedAttribute{
+				Description: "Locales used for the attributes matching the given patterns. Requires Meilisearch 1.10 or later.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"attribute_patterns": schema.ListAttribute{
+							Description: "Patterns of the attributes the locales apply to.",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+						"locales": schema.ListAttribute{
+							Description: "Locales of the matching attributes.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.OneOf(supportedLocales...)),
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+	r.providerData = data
+}
+
+// ValidateConfig fails when a custom ranking rule sorts on a field that is not
+// one of the sortable attributes configured alongside it.
+func (r *indexSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rankingRules types.List
+	var sortableAttributes types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ranking_rules"), &rankingRules)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sortable_attributes"), &sortableAttributes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sortable attributes left out of the configuration are not managed by
+	// the resource, so they cannot be checked here.
+	if rankingRules.IsNull() || rankingRules.IsUnknown() || sortableAttributes.IsNull() || sortableAttributes.IsUnknown() {
+		return
+	}
+
+	var sortable []string
+	for _, attribute := range sortableAttributes.Elements() {
+		attribute, ok := attribute.(types.String)
+		if !ok || attribute.IsUnknown() {
+			// An unknown sortable attribute may match any field
+			return
+		}
+
+		sortable = append(sortable, attribute.ValueString())
+	}
+
+	for i, rule := range rankingRules.Elements() {
+		rule, ok := rule.(types.String)
+		if !ok || rule.IsNull() || rule.IsUnknown() {
+			continue
+		}
+
+		field, _, custom := parseCustomRankingRule(rule.ValueString())
+		if custom && !slices.Contains(sortable, field) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ranking_rules").AtListIndex(i),
+				"Field Not Sortable",
+				fmt.Sprintf("Ranking rule %q sorts on %q, which is not one of the configured sortable_attributes. "+
+					"Add %q to sortable_attributes.", rule.ValueString(), field, field),
+			)
+		}
+	}
+}
+
+// ModifyPlan fails the plan when localized attributes or proximity precision
+// are configured and the server does not support them.
+func (r *indexSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Config.Raw.IsNull() {
+		return
+	}
+
+	var config indexSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.LocalizedAttributes.IsNull() {
+		resp.Diagnostics.Append(r.providerData.requireServerVersionForPlan(ctx, req, "Localized attributes", localizedAttributesMinVersion,
+			path.Root("localized_attributes"))...)
+	}
+
+	if !config.ProximityPrecision.IsNull() {
+		resp.Diagnostics.Append(r.providerData.requireServerVersionForPlan(ctx, req, "Proximity precision", proximityPrecisionMinVersion,
+			path.Root("proximity_precision"))...)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan and config
+	var plan, config indexSettingsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applySettings(ctx, &config, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexSettingsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed settings from Meilisearch
+	settings, err := r.client.Index(state.IndexUID.ValueString()).GetSettingsWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Reading Meilisearch Index Settings",
+				"Could not read settings of Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(flattenIndexSettings(ctx, settings, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and config
+	var plan, config indexSettingsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.Config.Get(ctx, &config)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applySettings(ctx, &config, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexSettingsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Reset settings to their default values
+	task, err := r.client.Index(state.IndexUID.ValueString()).ResetSettingsWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resetting Meilisearch Index Settings",
+			"Could not reset index settings, unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+}
+
+func (r *indexSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import UID and save to index_uid attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("index_uid"), req, resp)
+}
+
+// applySettings sends the configured settings to Meilisearch, waits for the
+// resulting tasks and refreshes the plan with the settings read back. Only
+// values present in the configuration are sent, so settings managed elsewhere
+// are left untouched.
+func (r *indexSettingsResource) applySettings(ctx context.Context, config, plan *indexSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	index := r.client.Index(plan.IndexUID.ValueString())
+
+	// Nested settings are merged with the current ones so that attributes
+	// left out of the configuration keep their value.
+	current, err := index.GetSettingsWithContext(ctx)
+	if err != nil && !apierror.IsNotFound(err) {
+		diags.AddError(
+			"Error Reading Meilisearch Index Settings",
+			"Could not read settings of Meilisearch index "+plan.IndexUID.ValueString()+": "+apierror.Describe(err),
+		)
+		return diags
+	}
+	if current == nil {
+		current = &meilisearch.Settings{}
+	}
+
+	settings, resets, expandDiags := expandIndexSettings(ctx, config, current)
+	diags.Append(expandDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, reset := range resets {
+		task, err := reset(index, ctx)
+		if err != nil {
+			diags.AddError(
+				"Error Updating Meilisearch Index Settings",
+				"Could not reset index setting, unexpected error: "+apierror.Describe(err),
+			)
+			return diags
+		}
+
+		diags.Append(waitForTask(ctx, r.client, task)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	task, err := index.UpdateSettingsWithContext(ctx, settings)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Meilisearch Index Settings",
+			"Could not update index settings, unexpected error: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(waitForTask(ctx, r.client, task)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	refreshed, err := index.GetSettingsWithContext(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Meilisearch Index Settings",
+			"Could not read settings of Meilisearch index "+plan.IndexUID.ValueString()+": "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(flattenIndexSettings(ctx, refreshed, plan)...)
+
+	plan.ID = types.StringValue("placeholder")
+
+	return diags
+}
+
+// settingsReset resets a single index setting to its default value.
+type settingsReset func(index meilisearch.IndexManager, ctx context.Context) (*meilisearch.TaskInfo, error)
+
+// expandIndexSettings builds the settings update request from the known
+// values of the model. Collections configured as empty cannot be sent through
+// the settings update route, so they are returned as individual resets.
+func expandIndexSettings(ctx context.Context, model *indexSettingsResourceModel, current *meilisearch.Settings) (*meilisearch.Settings, []settingsReset, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var resets []settingsReset
+
+	settings := &meilisearch.Settings{}
+
+	expandStrings := func(value attr.Value, target *[]string, reset settingsReset) {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+
+		var elements []string
+
+		switch v := value.(type) {
+		case types.List:
+			diags.Append(v.ElementsAs(ctx, &elements, false)...)
+		case types.Set:
+			diags.Append(v.ElementsAs(ctx, &elements, false)...)
+		}
+
+		if len(elements) == 0 {
+			resets = append(resets, reset)
+			return
+		}
+
+		*target = elements
+	}
+
+	expandStrings(model.RankingRules, &settings.RankingRules, meilisearch.IndexManager.ResetRankingRulesWithContext)
+	expandStrings(model.SearchableAttributes, &settings.SearchableAttributes, meilisearch.IndexManager.ResetSearchableAttributesWithContext)
+	expandStrings(model.DisplayedAttributes, &settings.DisplayedAttributes, meilisearch.IndexManager.ResetDisplayedAttributesWithContext)
+	expandStrings(model.FilterableAttributes, &settings.FilterableAttributes, meilisearch.IndexManager.ResetFilterableAttributesWithContext)
+	expandStrings(model.SortableAttributes, &settings.SortableAttributes, meilisearch.IndexManager.ResetSortableAttributesWithContext)
+	expandStrings(model.StopWords, &settings.StopWords, meilisearch.IndexManager.ResetStopWordsWithContext)
+	expandStrings(model.Dictionary, &settings.Dictionary, meilisearch.IndexManager.ResetDictionaryWithContext)
+	expandStrings(model.SeparatorTokens, &settings.SeparatorTokens, meilisearch.IndexManager.ResetSeparatorTokensWithContext)
+	expandStrings(model.NonSeparatorTokens, &settings.NonSeparatorTokens, meilisearch.IndexManager.ResetNonSeparatorTokensWithContext)
+
+	if !model.DistinctAttribute.IsNull() && !model.DistinctAttribute.IsUnknown() {
+		settings.DistinctAttribute = model.DistinctAttribute.ValueStringPointer()
+	}
+
+	if !model.Synonyms.IsNull() && !model.Synonyms.IsUnknown() {
+		synonyms := map[string][]string{}
+		diags.Append(model.Synonyms.ElementsAs(ctx, &synonyms, false)...)
+
+		if len(synonyms) == 0 {
+			resets = append(resets, meilisearch.IndexManager.ResetSynonymsWithContext)
+		} else {
+			settings.Synonyms = synonyms
+		}
+	}
+
+	if !model.ProximityPrecision.IsNull() && !model.ProximityPrecision.IsUnknown() {
+		settings.ProximityPrecision = meilisearch.ProximityPrecisionType(model.ProximityPrecision.ValueString())
+	}
+
+	if !model.SearchCutoffMs.IsNull() && !model.SearchCutoffMs.IsUnknown() {
+		settings.SearchCutoffMs = model.SearchCutoffMs.ValueInt64()
+	}
+
+	if !model.TypoTolerance.IsNull() && !model.TypoTolerance.IsUnknown() {
+		var typoTolerance typoToleranceModel
+		diags.Append(model.TypoTolerance.As(ctx, &typoTolerance, basetypes.ObjectAsOptions{})...)
+
+		merged := meilisearch.TypoTolerance{}
+		if current.TypoTolerance != nil {
+			merged = *current.TypoTolerance
+		}
+
+		if !typoTolerance.Enabled.IsNull() && !typoTolerance.Enabled.IsUnknown() {
+			merged.Enabled = typoTolerance.Enabled.ValueBool()
+		}
+
+		if !typoTolerance.MinWordSizeForTypos.IsNull() && !typoTolerance.MinWordSizeForTypos.IsUnknown() {
+			var minWordSize minWordSizeForTyposModel
+			diags.Append(typoTolerance.MinWordSizeForTypos.As(ctx, &minWordSize, basetypes.ObjectAsOptions{})...)
+
+			if !minWordSize.OneTypo.IsNull() && !minWordSize.OneTypo.IsUnknown() {
+				merged.MinWordSizeForTypos.OneTypo = minWordSize.OneTypo.ValueInt64()
+			}
+			if !minWordSize.TwoTypos.IsNull() && !minWordSize.TwoTypos.IsUnknown() {
+				merged.MinWordSizeForTypos.TwoTypos = minWordSize.TwoTypos.ValueInt64()
+			}
+		}
+
+		// Word and attribute lists are omitted from the request when empty,
+		// so clearing them requires resetting typo tolerance beforehand.
+		clearing := false
+
+		if !typoTolerance.DisableOnWords.IsNull() && !typoTolerance.DisableOnWords.IsUnknown() {
+			merged.DisableOnWords = nil
+			diags.Append(typoTolerance.DisableOnWords.ElementsAs(ctx, &merged.DisableOnWords, false)...)
+			clearing = clearing || len(merged.DisableOnWords) == 0
+		}
+
+		if !typoTolerance.DisableOnAttributes.IsNull() && !typoTolerance.DisableOnAttributes.IsUnknown() {
+			merged.DisableOnAttributes = nil
+			diags.Append(typoTolerance.DisableOnAttributes.ElementsAs(ctx, &merged.DisableOnAttributes, false)...)
+			clearing = clearing || len(merged.DisableOnAttributes) == 0
+		}
+
+		if clearing {
+			resets = append(resets, meilisearch.IndexManager.ResetTypoToleranceWithContext)
+		}
+
+		settings.TypoTolerance = &merged
+	}
+
+	if !model.Pagination.IsNull() && !model.Pagination.IsUnknown() {
+		var pagination paginationModel
+		diags.Append(model.Pagination.As(ctx, &pagination, basetypes.ObjectAsOptions{})...)
+
+		merged := meilisearch.Pagination{}
+		if current.Pagination != nil {
+			merged = *current.Pagination
+		}
+
+		if !pagination.MaxTotalHits.IsNull() && !pagination.MaxTotalHits.IsUnknown() {
+			merged.MaxTotalHits = pagination.MaxTotalHits.ValueInt64()
+		}
+
+		settings.Pagination = &merged
+	}
+
+	if !model.Faceting.IsNull() && !model.Faceting.IsUnknown() {
+		var faceting facetingModel
+		diags.Append(model.Faceting.As(ctx, &faceting, basetypes.ObjectAsOptions{})...)
+
+		merged := meilisearch.Faceting{}
+		if current.Faceting != nil {
+			merged = *current.Faceting
+		}
+
+		if !faceting.MaxValuesPerFacet.IsNull() && !faceting.MaxValuesPerFacet.IsUnknown() {
+			merged.MaxValuesPerFacet = faceting.MaxValuesPerFacet.ValueInt64()
+		}
+
+		if !faceting.SortFacetValuesBy.IsNull() && !faceting.SortFacetValuesBy.IsUnknown() {
+			sortFacetValuesBy := map[string]string{}
+			diags.Append(faceting.SortFacetValuesBy.ElementsAs(ctx, &sortFacetValuesBy, false)...)
+
+			merged.SortFacetValuesBy = map[string]meilisearch.SortFacetType{}
+			for attribute, sort := range sortFacetValuesBy {
+				merged.SortFacetValuesBy[attribute] = meilisearch.SortFacetType(sort)
+			}
+		}
+
+		settings.Faceting = &merged
+	}
+
+	if !model.LocalizedAttributes.IsNull() && !model.LocalizedAttributes.IsUnknown() {
+		var localizedAttributes []localizedAttributeModel
+		diags.Append(model.LocalizedAttributes.ElementsAs(ctx, &localizedAttributes, false)...)
+
+		if len(localizedAttributes) == 0 {
+			resets = append(resets, meilisearch.IndexManager.ResetLocalizedAttributesWithContext)
+		}
+
+		for _, localizedAttribute := range localizedAttributes {
+			rule := &meilisearch.LocalizedAttributes{}
+			diags.Append(localizedAttribute.AttributePatterns.ElementsAs(ctx, &rule.AttributePatterns, false)...)
+			diags.Append(localizedAttribute.Locales.ElementsAs(ctx, &rule.Locales, false)...)
+			settings.LocalizedAttributes = append(settings.LocalizedAttributes, rule)
+		}
+	}
+
+	return settings, resets, diags
+}
+
+// flattenIndexSettings maps the settings returned by Meilisearch to the model.
+func flattenIndexSettings(ctx context.Context, settings *meilisearch.Settings, model *indexSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	model.RankingRules, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(settings.RankingRules))
+	diags.Append(d...)
+	model.SearchableAttributes, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(settings.SearchableAttributes))
+	diags.Append(d...)
+	model.DisplayedAttributes, d = types.ListValueFrom(ctx, types.StringType, nonNilStrings(settings.DisplayedAttributes))
+	diags.Append(d...)
+	model.FilterableAttributes, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.FilterableAttributes))
+	diags.Append(d...)
+	model.SortableAttributes, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.SortableAttributes))
+	diags.Append(d...)
+	model.StopWords, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.StopWords))
+	diags.Append(d...)
+	model.Dictionary, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.Dictionary))
+	diags.Append(d...)
+	model.SeparatorTokens, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.SeparatorTokens))
+	diags.Append(d...)
+	model.NonSeparatorTokens, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.NonSeparatorTokens))
+	diags.Append(d...)
+
+	synonyms := settings.Synonyms
+	if synonyms == nil {
+		synonyms = map[string][]string{}
+	}
+	model.Synonyms, d = types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, synonyms)
+	diags.Append(d...)
+
+	model.DistinctAttribute = types.StringPointerValue(settings.DistinctAttribute)
+
+	if settings.ProximityPrecision == "" {
+		model.ProximityPrecision = types.StringNull()
+	} else {
+		model.ProximityPrecision = types.StringValue(string(settings.ProximityPrecision))
+	}
+
+	if settings.SearchCutoffMs == 0 {
+		model.SearchCutoffMs = types.Int64Null()
+	} else {
+		model.SearchCutoffMs = types.Int64Value(settings.SearchCutoffMs)
+	}
+
+	if settings.TypoTolerance == nil {
+		model.TypoTolerance = types.ObjectNull(typoToleranceAttrTypes)
+	} else {
+		minWordSize, d := types.ObjectValueFrom(ctx, minWordSizeForTyposAttrTypes, minWordSizeForTyposModel{
+			OneTypo:  types.Int64Value(settings.TypoTolerance.MinWordSizeForTypos.OneTypo),
+			TwoTypos: types.Int64Value(settings.TypoTolerance.MinWordSizeForTypos.TwoTypos),
+		})
+		diags.Append(d...)
+
+		disableOnWords, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.TypoTolerance.DisableOnWords))
+		diags.Append(d...)
+
+		disableOnAttributes, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.TypoTolerance.DisableOnAttributes))
+		diags.Append(d...)
+
+		model.TypoTolerance, d = types.ObjectValueFrom(ctx, typoToleranceAttrTypes, typoToleranceModel{
+			Enabled:             types.BoolValue(settings.TypoTolerance.Enabled),
+			MinWordSizeForTypos: minWordSize,
+			DisableOnWords:      disableOnWords,
+			DisableOnAttributes: disableOnAttributes,
+		})
+		diags.Append(d...)
+	}
+
+	if settings.Pagination == nil {
+		model.Pagination = types.ObjectNull(paginationAttrTypes)
+	} else {
+		model.Pagination, d = types.ObjectValueFrom(ctx, paginationAttrTypes, paginationModel{
+			MaxTotalHits: types.Int64Value(settings.Pagination.MaxTotalHits),
+		})
+		diags.Append(d...)
+	}
+
+	if settings.Faceting == nil {
+		model.Faceting = types.ObjectNull(facetingAttrTypes)
+	} else {
+		sortFacetValuesBy := map[string]string{}
+		for attribute, sort := range settings.Faceting.SortFacetValuesBy {
+			sortFacetValuesBy[attribute] = string(sort)
+		}
+
+		sortFacetValuesByValue, d := types.MapValueFrom(ctx, types.StringType, sortFacetValuesBy)
+		diags.Append(d...)
+
+		model.Faceting, d = types.ObjectValueFrom(ctx, facetingAttrTypes, facetingModel{
+			MaxValuesPerFacet: types.Int64Value(settings.Faceting.MaxValuesPerFacet),
+			SortFacetValuesBy: sortFacetValuesByValue,
+		})
+		diags.Append(d...)
+	}
+
+	localizedAttributes := []localizedAttributeModel{}
+	for _, rule := range settings.LocalizedAttributes {
+		attributePatterns, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(rule.AttributePatterns))
+		diags.Append(d...)
+
+		locales, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(rule.Locales))
+		diags.Append(d...)
+
+		localizedAttributes = append(localizedAttributes, localizedAttributeModel{
+			AttributePatterns: attributePatterns,
+			Locales:           locales,
+		})
+	}
+	model.LocalizedAttributes, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: localizedAttributeAttrTypes}, localizedAttributes)
+	diags.Append(d...)
+
+	return diags
+}
+
+// nonNilStrings avoids null values in state for collections Meilisearch
+// returns as empty.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
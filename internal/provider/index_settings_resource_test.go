@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "settings-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_settings" "test" {
+	index_uid = meilisearch_index.test.uid
+	searchable_attributes = ["title", "overview"]
+	filterable_attributes = ["genres"]
+	stop_words = ["the", "a"]
+	synonyms = {
+		"phone" = ["iphone"]
+	}
+	pagination = {
+		max_total_hits = 5000
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify configured attributes are set
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "index_uid", "settings-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "searchable_attributes.#", "2"),
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "searchable_attributes.0", "title"),
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "searchable_attributes.1", "overview"),
+					resource.TestCheckTypeSetElemAttr("meilisearch_index_settings.test", "filterable_attributes.*", "genres"),
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "stop_words.#", "2"),
+					resource.TestCheckTypeSetElemAttr("meilisearch_index_settings.test", "synonyms.phone.*", "iphone"),
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "pagination.max_total_hits", "5000"),
+					// Verify settings left out of the configuration are read back
+					resource.TestCheckResourceAttrSet("meilisearch_index_settings.test", "ranking_rules.#"),
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "typo_tolerance.enabled", "true"),
+					resource.TestCheckResourceAttrSet("meilisearch_index_settings.test", "faceting.max_values_per_facet"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "meilisearch_index_settings.test",
+				ImportStateId:                        "settings-index-uid",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index_uid",
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "settings-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_settings" "test" {
+	index_uid = meilisearch_index.test.uid
+	searchable_attributes = ["title", "overview"]
+	filterable_attributes = ["genres", "release_date"]
+	stop_words = []
+	synonyms = {
+		"phone" = ["iphone"]
+	}
+	pagination = {
+		max_total_hits = 5000
+	}
+	typo_tolerance = {
+		min_word_size_for_typos = {
+			one_typo = 4
+			two_typos = 10
+		}
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "filterable_attributes.#", "2"),
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "stop_words.#", "0"),
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "typo_tolerance.enabled", "true"),
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "typo_tolerance.min_word_size_for_typos.one_typo", "4"),
+					resource.TestCheckResourceAttr("meilisearch_index_settings.test", "typo_tolerance.min_word_size_for_typos.two_typos", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestIndexSettingsValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	stringValues := func(values ...string) []attr.Value {
+		elements := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+
+		return elements
+	}
+
+	testCases := map[string]struct {
+		rankingRules       types.List
+		sortableAttributes types.Set
+		expectedErrors     []path.Path
+	}{
+		"sortable": {
+			rankingRules:       types.ListValueMust(types.StringType, stringValues("words", "price:asc")),
+			sortableAttributes: types.SetValueMust(types.StringType, stringValues("price")),
+		},
+		"not sortable": {
+			rankingRules:       types.ListValueMust(types.StringType, stringValues("words", "price:asc", "release_date:desc")),
+			sortableAttributes: types.SetValueMust(types.StringType, stringValues("price")),
+			expectedErrors:     []path.Path{path.Root("ranking_rules").AtListIndex(2)},
+		},
+		"no sortable attributes": {
+			rankingRules:       types.ListValueMust(types.StringType, stringValues("price:asc")),
+			sortableAttributes: types.SetValueMust(types.StringType, nil),
+			expectedErrors:     []path.Path{path.Root("ranking_rules").AtListIndex(0)},
+		},
+		"sortable attributes not managed": {
+			rankingRules:       types.ListValueMust(types.StringType, stringValues("price:asc")),
+			sortableAttributes: types.SetNull(types.StringType),
+		},
+		"sortable attributes unknown": {
+			rankingRules:       types.ListValueMust(types.StringType, stringValues("price:asc")),
+			sortableAttributes: types.SetUnknown(types.StringType),
+		},
+		"sortable attribute unknown": {
+			rankingRules:       types.ListValueMust(types.StringType, stringValues("price:asc")),
+			sortableAttributes: types.SetValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+		},
+		"ranking rule unknown": {
+			rankingRules:       types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+			sortableAttributes: types.SetValueMust(types.StringType, nil),
+		},
+	}
+
+	r := &indexSettingsResource{}
+
+	var schemaResp fwresource.SchemaResponse
+
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+
+			diags := state.SetAttribute(ctx, path.Root("ranking_rules"), testCase.rankingRules)
+			diags.Append(state.SetAttribute(ctx, path.Root("sortable_attributes"), testCase.sortableAttributes)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			resp := &fwresource.ValidateConfigResponse{}
+
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+
+			var errorPaths []path.Path
+			for _, d := range resp.Diagnostics.Errors() {
+				withPath, ok := d.(interface{ Path() path.Path })
+				if !ok {
+					t.Fatalf("unexpected error without attribute path: %v", d)
+				}
+
+				errorPaths = append(errorPaths, withPath.Path())
+			}
+
+			if !reflect.DeepEqual(errorPaths, testCase.expectedErrors) {
+				t.Errorf("expected errors at %v, got %v", testCase.expectedErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &indexStatsDataSource{}
+	_ datasource.DataSourceWithConfigure = &indexStatsDataSource{}
+)
+
+func NewIndexStatsDataSource() datasource.DataSource {
+	return &indexStatsDataSource{}
+}
+
+// indexStatsDataSource defines the data source implementation.
+type indexStatsDataSource struct {
+	client meilisearch.ServiceManager
+}
+
+type indexStatsDataSourceModel struct {
+	IndexUID                  types.String `tfsdk:"index_uid"`
+	NumberOfDocuments         types.Int64  `tfsdk:"number_of_documents"`
+	IsIndexing                types.Bool   `tfsdk:"is_indexing"`
+	FieldDistribution         types.Map    `tfsdk:"field_distribution"`
+	RawDocumentDbSize         types.Int64  `tfsdk:"raw_document_db_size"`
+	AvgDocumentSize           types.Int64  `tfsdk:"avg_document_size"`
+	NumberOfEmbeddedDocuments types.Int64  `tfsdk:"number_of_embedded_documents"`
+	NumberOfEmbeddings        types.Int64  `tfsdk:"number_of_embeddings"`
+	ID                        types.String `tfsdk:"id"`
+}
+
+func (d *indexStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_stats"
+}
+
+func (d *indexStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the statistics of a Meilisearch index.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+			},
+			"number_of_documents": schema.Int64Attribute{
+				Description: "Number of documents in the index.",
+				Computed:    true,
+			},
+			"is_indexing": schema.BoolAttribute{
+				Description: "Whether the index is currently processing a task.",
+				Computed:    true,
+			},
+			"field_distribution": schema.MapAttribute{
+				Description: "Number of documents containing each field.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+			"raw_document_db_size": schema.Int64Attribute{
+				Description: "Size of the stored documents, in bytes.",
+				Computed:    true,
+			},
+			"avg_document_size": schema.Int64Attribute{
+				Description: "Average size of a document, in bytes.",
+				Computed:    true,
+			},
+			"number_of_embedded_documents": schema.Int64Attribute{
+				Description: "Number of documents with at least one embedding.",
+				Computed:    true,
+			},
+			"number_of_embeddings": schema.Int64Attribute{
+				Description: "Total number of embeddings in the index.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *indexStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state indexStatsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stats, err := d.client.Index(state.IndexUID.ValueString()).GetStatsWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Meilisearch Index Stats",
+			apierror.Describe(err),
+		)
+		return
+	}
+
+	fieldDistribution, diags := types.MapValueFrom(ctx, types.Int64Type, stats.FieldDistribution)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to model
+	state.NumberOfDocuments = types.Int64Value(stats.NumberOfDocuments)
+	state.IsIndexing = types.BoolValue(stats.IsIndexing)
+	state.FieldDistribution = fieldDistribution
+	state.RawDocumentDbSize = types.Int64Value(stats.RawDocumentDbSize)
+	state.AvgDocumentSize = types.Int64Value(stats.AvgDocumentSize)
+	state.NumberOfEmbeddedDocuments = types.Int64Value(stats.NumberOfEmbeddedDocuments)
+	state.NumberOfEmbeddings = types.Int64Value(stats.NumberOfEmbeddings)
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *indexStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the data source")
+		return
+	}
+
+	d.client = data.client
+}
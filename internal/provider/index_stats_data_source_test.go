@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexStatsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "meilisearch_index_stats" "test" {
+	index_uid = "test_index"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meilisearch_index_stats.test", "index_uid", "test_index"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_index_stats.test", "number_of_documents"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_index_stats.test", "is_indexing"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_index_stats.test", "field_distribution.%"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_index_stats.test", "raw_document_db_size"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_index_stats.test", "avg_document_size"),
+					// Verify ID placeholder attribute is set
+					resource.TestCheckResourceAttr("data.meilisearch_index_stats.test", "id", "placeholder"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,453 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &indexSynonymsResource{}
+	_ resource.ResourceWithConfigure   = &indexSynonymsResource{}
+	_ resource.ResourceWithImportState = &indexSynonymsResource{}
+	_ resource.ResourceWithModifyPlan  = &indexSynonymsResource{}
+)
+
+// NewIndexSynonymsResource is a helper function to simplify the provider implementation.
+func NewIndexSynonymsResource() resource.Resource {
+	return &indexSynonymsResource{}
+}
+
+// indexSynonymsResource is the resource implementation.
+type indexSynonymsResource struct {
+	client meilisearch.ServiceManager
+}
+
+type indexSynonymsResourceModel struct {
+	IndexUID          types.String   `tfsdk:"index_uid"`
+	Synonyms          types.Map      `tfsdk:"synonyms"`
+	Groups            types.Set      `tfsdk:"group"`
+	EffectiveSynonyms types.Map      `tfsdk:"effective_synonyms"`
+	ID                types.String   `tfsdk:"id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+type synonymGroupModel struct {
+	Terms types.Set `tfsdk:"terms"`
+}
+
+var synonymsType = types.MapType{ElemType: types.SetType{ElemType: types.StringType}}
+
+// Metadata returns the resource type name.
+func (r *indexSynonymsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_synonyms"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexSynonymsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the synonyms of a Meilisearch index " +
+			"(see [official documentation](https://www.meilisearch.com/docs/learn/relevancy/synonyms)). " +
+			"One-way synonyms are set with `synonyms`, and mutual synonyms with `group` blocks. " +
+			"Synonyms edited outside of Terraform are detected as drift.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"synonyms": schema.MapAttribute{
+				Description: "One-way synonyms, mapping a word to the words that are searched along with it.",
+				ElementType: types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.ValueSetsAre(setvalidator.SizeAtLeast(1)),
+				},
+			},
+			"effective_synonyms": schema.MapAttribute{
+				Description: "Synonyms sent to Meilisearch, merging `synonyms` with the expanded `group` blocks.",
+				ElementType: types.SetType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"group": schema.SetNestedBlock{
+				Description: "Group of mutual synonyms: each term is a synonym of every other term of the group.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"terms": schema.SetAttribute{
+							Description: "Terms of the group.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(2),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexSynonymsResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+}
+
+// ModifyPlan computes the effective synonyms, so that the plan shows the
+// normalized synonyms actually sent to Meilisearch.
+func (r *indexSynonymsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan indexSynonymsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	synonyms, known, diags := expandSynonyms(ctx, plan.Synonyms, plan.Groups)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !known {
+		plan.EffectiveSynonyms = types.MapUnknown(synonymsType.ElemType)
+	} else {
+		plan.EffectiveSynonyms, diags = types.MapValueFrom(ctx, synonymsType.ElemType, synonyms)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	plan.ID = types.StringValue("placeholder")
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexSynonymsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexSynonymsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applySynonyms(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexSynonymsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexSynonymsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed synonyms from Meilisearch
+	synonyms, err := r.client.Index(state.IndexUID.ValueString()).GetSynonymsWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Reading Meilisearch Index Synonyms",
+				"Could not read synonyms of Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+			)
+			return
+		}
+	}
+
+	// Drift shows up as a difference between the configured and effective synonyms
+	state.EffectiveSynonyms, diags = types.MapValueFrom(ctx, synonymsType.ElemType, normalizeSynonyms(*synonyms))
+	resp.Diagnostics.Append(diags...)
+
+	// Nothing is configured yet when importing, so the synonyms are read as one-way synonyms
+	if state.Synonyms.IsNull() && state.Groups.IsNull() && len(*synonyms) > 0 {
+		state.Synonyms = state.EffectiveSynonyms
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexSynonymsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan indexSynonymsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applySynonyms(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexSynonymsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexSynonymsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	task, err := r.client.Index(state.IndexUID.ValueString()).ResetSynonymsWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Resetting Meilisearch Index Synonyms",
+			"Could not reset index synonyms, unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+}
+
+func (r *indexSynonymsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import UID and save to index_uid attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("index_uid"), req, resp)
+}
+
+// applySynonyms replaces the synonyms of the index with the effective
+// synonyms of the plan and waits for the resulting task.
+func (r *indexSynonymsResource) applySynonyms(ctx context.Context, plan *indexSynonymsResourceModel) diag.Diagnostics {
+	synonyms, _, diags := expandSynonyms(ctx, plan.Synonyms, plan.Groups)
+	if diags.HasError() {
+		return diags
+	}
+
+	task, err := r.client.Index(plan.IndexUID.ValueString()).UpdateSynonymsWithContext(ctx, &synonyms)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Meilisearch Index Synonyms",
+			"Could not update index synonyms, unexpected error: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(waitForTask(ctx, r.client, task)...)
+
+	var d diag.Diagnostics
+
+	plan.EffectiveSynonyms, d = types.MapValueFrom(ctx, synonymsType.ElemType, synonyms)
+	diags.Append(d...)
+
+	plan.ID = types.StringValue("placeholder")
+
+	return diags
+}
+
+// expandSynonyms merges one-way synonyms with groups of mutual synonyms, each
+// term of a group being a synonym of every other term. It reports whether
+// every value was known.
+func expandSynonyms(ctx context.Context, oneWay types.Map, groups types.Set) (map[string][]string, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if oneWay.IsUnknown() || groups.IsUnknown() {
+		return nil, false, diags
+	}
+
+	synonyms := map[string][]string{}
+
+	for word, value := range oneWay.Elements() {
+		terms, ok := value.(types.Set)
+		if !ok || terms.IsUnknown() {
+			return nil, false, diags
+		}
+
+		words, known := stringElements(terms.Elements())
+		if !known {
+			return nil, false, diags
+		}
+
+		synonyms[word] = append(synonyms[word], words...)
+	}
+
+	var groupModels []synonymGroupModel
+
+	for _, group := range groups.Elements() {
+		if group.IsUnknown() {
+			return nil, false, diags
+		}
+	}
+
+	diags.Append(groups.ElementsAs(ctx, &groupModels, false)...)
+	if diags.HasError() {
+		return nil, false, diags
+	}
+
+	for _, group := range groupModels {
+		if group.Terms.IsUnknown() {
+			return nil, false, diags
+		}
+
+		terms, known := stringElements(group.Terms.Elements())
+		if !known {
+			return nil, false, diags
+		}
+
+		for _, term := range terms {
+			for _, other := range terms {
+				if other != term {
+					synonyms[term] = append(synonyms[term], other)
+				}
+			}
+		}
+	}
+
+	return normalizeSynonyms(synonyms), true, diags
+}
+
+// normalizeSynonyms sorts and deduplicates the synonyms of every word, and
+// drops words without synonyms.
+func normalizeSynonyms(synonyms map[string][]string) map[string][]string {
+	normalized := make(map[string][]string, len(synonyms))
+
+	for word, terms := range synonyms {
+		terms = slices.Clone(terms)
+		slices.Sort(terms)
+		terms = slices.Compact(terms)
+
+		if len(terms) > 0 {
+			normalized[word] = terms
+		}
+	}
+
+	return normalized
+}
+
+// stringElements returns the values of string elements, and whether every
+// one of them was known.
+func stringElements(elements []attr.Value) ([]string, bool) {
+	values := make([]string, 0, len(elements))
+
+	for _, element := range elements {
+		value, ok := element.(types.String)
+		if !ok || value.IsUnknown() {
+			return nil, false
+		}
+
+		if !value.IsNull() {
+			values = append(values, value.ValueString())
+		}
+	}
+
+	return values, true
+}
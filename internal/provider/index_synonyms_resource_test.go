@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexSynonymsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "synonyms-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_synonyms" "test" {
+	index_uid = meilisearch_index.test.uid
+	synonyms = {
+		phone = ["iphone"]
+	}
+	group {
+		terms = ["sweater", "pullover", "jumper"]
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_synonyms.test", "index_uid", "synonyms-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_index_synonyms.test", "effective_synonyms.%", "4"),
+					resource.TestCheckTypeSetElemAttr("meilisearch_index_synonyms.test", "effective_synonyms.phone.*", "iphone"),
+					resource.TestCheckTypeSetElemAttr("meilisearch_index_synonyms.test", "effective_synonyms.jumper.*", "sweater"),
+					resource.TestCheckTypeSetElemAttr("meilisearch_index_synonyms.test", "effective_synonyms.jumper.*", "pullover"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "meilisearch_index_synonyms.test",
+				ImportStateId:                        "synonyms-index-uid",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index_uid",
+				ImportStateVerifyIgnore:              []string{"synonyms", "group", "timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "synonyms-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_synonyms" "test" {
+	index_uid = meilisearch_index.test.uid
+	synonyms = {
+		phone = ["iphone", "smartphone"]
+	}
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_synonyms.test", "effective_synonyms.%", "1"),
+					resource.TestCheckResourceAttr("meilisearch_index_synonyms.test", "effective_synonyms.phone.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestExpandSynonyms(t *testing.T) {
+	ctx := context.Background()
+
+	groupType := types.ObjectType{AttrTypes: map[string]attr.Type{"terms": types.SetType{ElemType: types.StringType}}}
+
+	oneWay, _ := types.MapValueFrom(ctx, synonymsType.ElemType, map[string][]string{
+		"phone":   {"iphone"},
+		"sweater": {"cardigan"},
+	})
+	groups, _ := types.SetValueFrom(ctx, groupType, []synonymGroupModel{
+		{Terms: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("sweater"), types.StringValue("jumper")})},
+	})
+
+	synonyms, known, diags := expandSynonyms(ctx, oneWay, groups)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !known {
+		t.Fatal("expected synonyms to be known")
+	}
+
+	expected := map[string][]string{
+		"phone":   {"iphone"},
+		"sweater": {"cardigan", "jumper"},
+		"jumper":  {"sweater"},
+	}
+
+	if !reflect.DeepEqual(synonyms, expected) {
+		t.Errorf("expected %v, got %v", expected, synonyms)
+	}
+
+	unknownGroups, _ := types.SetValueFrom(ctx, groupType, []synonymGroupModel{
+		{Terms: types.SetUnknown(types.StringType)},
+	})
+
+	if _, known, _ := expandSynonyms(ctx, types.MapNull(synonymsType.ElemType), unknownGroups); known {
+		t.Error("expected synonyms with unknown terms to be unknown")
+	}
+}
+
+func TestNormalizeSynonyms(t *testing.T) {
+	synonyms := normalizeSynonyms(map[string][]string{
+		"phone": {"smartphone", "iphone", "smartphone"},
+		"empty": {},
+	})
+
+	expected := map[string][]string{
+		"phone": {"iphone", "smartphone"},
+	}
+
+	if !reflect.DeepEqual(synonyms, expected) {
+		t.Errorf("expected %v, got %v", expected, synonyms)
+	}
+}
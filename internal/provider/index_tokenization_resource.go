@@ -0,0 +1,431 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &indexTokenizationResource{}
+	_ resource.ResourceWithConfigure      = &indexTokenizationResource{}
+	_ resource.ResourceWithImportState    = &indexTokenizationResource{}
+	_ resource.ResourceWithValidateConfig = &indexTokenizationResource{}
+)
+
+// NewIndexTokenizationResource is a helper function to simplify the provider implementation.
+func NewIndexTokenizationResource() resource.Resource {
+	return &indexTokenizationResource{}
+}
+
+// indexTokenizationResource is the resource implementation.
+type indexTokenizationResource struct {
+	client meilisearch.ServiceManager
+}
+
+type indexTokenizationResourceModel struct {
+	IndexUID           types.String   `tfsdk:"index_uid"`
+	Dictionary         types.Set      `tfsdk:"dictionary"`
+	SeparatorTokens    types.Set      `tfsdk:"separator_tokens"`
+	NonSeparatorTokens types.Set      `tfsdk:"non_separator_tokens"`
+	ID                 types.String   `tfsdk:"id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// tokenizationSetting binds an attribute of the resource to the endpoints of
+// the matching index setting.
+type tokenizationSetting struct {
+	name   string
+	value  func(*indexTokenizationResourceModel) *types.Set
+	get    func(context.Context, meilisearch.IndexManager) ([]string, error)
+	update func(context.Context, meilisearch.IndexManager, []string) (*meilisearch.TaskInfo, error)
+	reset  func(context.Context, meilisearch.IndexManager) (*meilisearch.TaskInfo, error)
+}
+
+var tokenizationSettings = []tokenizationSetting{
+	{
+		name:  "dictionary",
+		value: func(m *indexTokenizationResourceModel) *types.Set { return &m.Dictionary },
+		get: func(ctx context.Context, index meilisearch.IndexManager) ([]string, error) {
+			return index.GetDictionaryWithContext(ctx)
+		},
+		update: func(ctx context.Context, index meilisearch.IndexManager, words []string) (*meilisearch.TaskInfo, error) {
+			return index.UpdateDictionaryWithContext(ctx, words)
+		},
+		reset: func(ctx context.Context, index meilisearch.IndexManager) (*meilisearch.TaskInfo, error) {
+			return index.ResetDictionaryWithContext(ctx)
+		},
+	},
+	{
+		name:  "separator_tokens",
+		value: func(m *indexTokenizationResourceModel) *types.Set { return &m.SeparatorTokens },
+		get: func(ctx context.Context, index meilisearch.IndexManager) ([]string, error) {
+			return index.GetSeparatorTokensWithContext(ctx)
+		},
+		update: func(ctx context.Context, index meilisearch.IndexManager, tokens []string) (*meilisearch.TaskInfo, error) {
+			return index.UpdateSeparatorTokensWithContext(ctx, tokens)
+		},
+		reset: func(ctx context.Context, index meilisearch.IndexManager) (*meilisearch.TaskInfo, error) {
+			return index.ResetSeparatorTokensWithContext(ctx)
+		},
+	},
+	{
+		name:  "non_separator_tokens",
+		value: func(m *indexTokenizationResourceModel) *types.Set { return &m.NonSeparatorTokens },
+		get: func(ctx context.Context, index meilisearch.IndexManager) ([]string, error) {
+			return index.GetNonSeparatorTokensWithContext(ctx)
+		},
+		update: func(ctx context.Context, index meilisearch.IndexManager, tokens []string) (*meilisearch.TaskInfo, error) {
+			return index.UpdateNonSeparatorTokensWithContext(ctx, tokens)
+		},
+		reset: func(ctx context.Context, index meilisearch.IndexManager) (*meilisearch.TaskInfo, error) {
+			return index.ResetNonSeparatorTokensWithContext(ctx)
+		},
+	},
+}
+
+// Metadata returns the resource type name.
+func (r *indexTokenizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_tokenization"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexTokenizationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the tokenization settings of a Meilisearch index: user dictionary, separator and non-separator tokens " +
+			"(see [official documentation](https://www.meilisearch.com/docs/reference/api/settings#dictionary)). " +
+			"Attributes omitted from the configuration are set to an empty set.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dictionary": schema.SetAttribute{
+				Description: "Words, such as `C++` or `J. R. R.`, that are indexed and searched as single terms.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"separator_tokens": schema.SetAttribute{
+				Description: "Tokens that are considered as word separators in addition to the default ones.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"non_separator_tokens": schema.SetAttribute{
+				Description: "Tokens, such as `-` in `SKU-42`, that are no longer considered as word separators.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexTokenizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+}
+
+// ValidateConfig warns about tokens that are both separator and non-separator
+// tokens, as Meilisearch only honours one of them.
+func (r *indexTokenizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config indexTokenizationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	overlap := overlappingTokens(config.SeparatorTokens, config.NonSeparatorTokens)
+	if len(overlap) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("non_separator_tokens"),
+			"Conflicting Tokenization Settings",
+			fmt.Sprintf("The following tokens are both separator and non-separator tokens: %s. "+
+				"Tokenization of these tokens may not behave as expected.", strings.Join(overlap, ", ")),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexTokenizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexTokenizationResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyTokenization(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexTokenizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexTokenizationResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	index := r.client.Index(state.IndexUID.ValueString())
+
+	for _, setting := range tokenizationSettings {
+		// Get refreshed tokens from Meilisearch
+		tokens, err := setting.get(ctx, index)
+		if err != nil {
+			if apierror.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			} else {
+				resp.Diagnostics.AddError(
+					"Error Reading Meilisearch Index Tokenization",
+					"Could not read "+setting.name+" of Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+				)
+				return
+			}
+		}
+
+		*setting.value(&state), diags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(tokens))
+		resp.Diagnostics.Append(diags...)
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexTokenizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state indexTokenizationResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.applyTokenization(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexTokenizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexTokenizationResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	index := r.client.Index(state.IndexUID.ValueString())
+
+	var tasks []meilisearch.TaskInfo
+
+	for _, setting := range tokenizationSettings {
+		task, err := setting.reset(ctx, index)
+		if err != nil {
+			if apierror.IsNotFound(err) {
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Error Resetting Meilisearch Index Tokenization",
+				"Could not reset index "+setting.name+", unexpected error: "+apierror.Describe(err),
+			)
+			return
+		}
+
+		tasks = append(tasks, *task)
+	}
+
+	resp.Diagnostics.Append(waitForTasks(ctx, r.client, tasks)...)
+}
+
+func (r *indexTokenizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import UID and save to index_uid attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("index_uid"), req, resp)
+}
+
+// applyTokenization sends the planned tokens of every setting that differs
+// from the state, or of every setting when there is no state yet, and waits
+// for the resulting tasks. Empty sets are applied by resetting the setting.
+func (r *indexTokenizationResource) applyTokenization(ctx context.Context, plan, state *indexTokenizationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	index := r.client.Index(plan.IndexUID.ValueString())
+
+	var tasks []meilisearch.TaskInfo
+
+	for _, setting := range tokenizationSettings {
+		planned := *setting.value(plan)
+
+		if state != nil && planned.Equal(*setting.value(state)) {
+			continue
+		}
+
+		var tokens []string
+
+		diags.Append(planned.ElementsAs(ctx, &tokens, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		var task *meilisearch.TaskInfo
+		var err error
+
+		if len(tokens) == 0 {
+			task, err = setting.reset(ctx, index)
+		} else {
+			task, err = setting.update(ctx, index, tokens)
+		}
+
+		if err != nil {
+			diags.AddError(
+				"Error Updating Meilisearch Index Tokenization",
+				"Could not update index "+setting.name+", unexpected error: "+apierror.Describe(err),
+			)
+			return diags
+		}
+
+		tasks = append(tasks, *task)
+	}
+
+	diags.Append(waitForTasks(ctx, r.client, tasks)...)
+
+	plan.ID = types.StringValue("placeholder")
+
+	return diags
+}
+
+// overlappingTokens returns the known tokens present in both sets, sorted.
+func overlappingTokens(separatorTokens, nonSeparatorTokens types.Set) []string {
+	var overlap []string
+
+	for _, element := range nonSeparatorTokens.Elements() {
+		token, ok := element.(types.String)
+		if ok && !token.IsUnknown() && !token.IsNull() && slices.ContainsFunc(separatorTokens.Elements(), token.Equal) {
+			overlap = append(overlap, token.ValueString())
+		}
+	}
+
+	slices.Sort(overlap)
+
+	return overlap
+}
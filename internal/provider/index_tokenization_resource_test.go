@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexTokenizationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "tokenization-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_tokenization" "test" {
+	index_uid = meilisearch_index.test.uid
+	dictionary = ["C++", "C#"]
+	separator_tokens = ["|"]
+	non_separator_tokens = ["-"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_tokenization.test", "index_uid", "tokenization-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_index_tokenization.test", "dictionary.#", "2"),
+					resource.TestCheckTypeSetElemAttr("meilisearch_index_tokenization.test", "dictionary.*", "C++"),
+					resource.TestCheckTypeSetElemAttr("meilisearch_index_tokenization.test", "separator_tokens.*", "|"),
+					resource.TestCheckTypeSetElemAttr("meilisearch_index_tokenization.test", "non_separator_tokens.*", "-"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "meilisearch_index_tokenization.test",
+				ImportStateId:                        "tokenization-index-uid",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index_uid",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "tokenization-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_tokenization" "test" {
+	index_uid = meilisearch_index.test.uid
+	dictionary = ["C++"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_tokenization.test", "dictionary.#", "1"),
+					resource.TestCheckResourceAttr("meilisearch_index_tokenization.test", "separator_tokens.#", "0"),
+					resource.TestCheckResourceAttr("meilisearch_index_tokenization.test", "non_separator_tokens.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestOverlappingTokens(t *testing.T) {
+	separators := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("-"),
+		types.StringValue("|"),
+		types.StringValue("_"),
+	})
+	nonSeparators := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("_"),
+		types.StringValue("-"),
+		types.StringValue("+"),
+		types.StringUnknown(),
+	})
+
+	overlap := overlappingTokens(separators, nonSeparators)
+
+	if expected := []string{"-", "_"}; !reflect.DeepEqual(overlap, expected) {
+		t.Errorf("expected %v, got %v", expected, overlap)
+	}
+
+	if overlap := overlappingTokens(types.SetNull(types.StringType), nonSeparators); len(overlap) != 0 {
+		t.Errorf("expected no overlap, got %v", overlap)
+	}
+}
@@ -0,0 +1,456 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Default minimum word sizes for accepting typos, as documented by Meilisearch.
+const (
+	defaultMinWordSizeForOneTypo  = 5
+	defaultMinWordSizeForTwoTypos = 9
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &indexTypoToleranceResource{}
+	_ resource.ResourceWithConfigure   = &indexTypoToleranceResource{}
+	_ resource.ResourceWithImportState = &indexTypoToleranceResource{}
+)
+
+// NewIndexTypoToleranceResource is a helper function to simplify the provider implementation.
+func NewIndexTypoToleranceResource() resource.Resource {
+	return &indexTypoToleranceResource{}
+}
+
+// indexTypoToleranceResource is the resource implementation.
+type indexTypoToleranceResource struct {
+	client meilisearch.ServiceManager
+}
+
+type indexTypoToleranceResourceModel struct {
+	IndexUID            types.String   `tfsdk:"index_uid"`
+	Enabled             types.Bool     `tfsdk:"enabled"`
+	MinWordSizeForTypos types.Object   `tfsdk:"min_word_size_for_typos"`
+	DisableOnWords      types.Set      `tfsdk:"disable_on_words"`
+	DisableOnAttributes types.Set      `tfsdk:"disable_on_attributes"`
+	ID                  types.String   `tfsdk:"id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *indexTypoToleranceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_index_typo_tolerance"
+}
+
+// Schema defines the schema for the resource.
+func (r *indexTypoToleranceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the typo tolerance settings of a Meilisearch index " +
+			"(see [official documentation](https://www.meilisearch.com/docs/reference/api/settings#typo-tolerance)). " +
+			"Attributes omitted from the configuration are set to their default value.",
+		Attributes: map[string]schema.Attribute{
+			"index_uid": schema.StringAttribute{
+				Description: "Unique identifier of the index.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether typo tolerance is enabled. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"min_word_size_for_typos": schema.SingleNestedAttribute{
+				Description: "Minimum word sizes for accepting typos.",
+				Optional:    true,
+				Computed:    true,
+				Default: objectdefault.StaticValue(types.ObjectValueMust(minWordSizeForTyposAttrTypes, map[string]attr.Value{
+					"one_typo":  types.Int64Value(defaultMinWordSizeForOneTypo),
+					"two_typos": types.Int64Value(defaultMinWordSizeForTwoTypos),
+				})),
+				Validators: []validator.Object{
+					minWordSizeForTyposValidator{useDefaults: true},
+				},
+				Attributes: map[string]schema.Attribute{
+					"one_typo": schema.Int64Attribute{
+						Description: fmt.Sprintf("Minimum word size for accepting 1 typo. Defaults to `%d`.", defaultMinWordSizeForOneTypo),
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(defaultMinWordSizeForOneTypo),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"two_typos": schema.Int64Attribute{
+						Description: fmt.Sprintf("Minimum word size for accepting 2 typos, which must be greater than or equal to `one_typo`. Defaults to `%d`.", defaultMinWordSizeForTwoTypos),
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(defaultMinWordSizeForTwoTypos),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"disable_on_words": schema.SetAttribute{
+				Description: "Words for which typo tolerance is disabled.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"disable_on_attributes": schema.SetAttribute{
+				Description: "Attributes for which typo tolerance is disabled.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *indexTypoToleranceResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
+	}
+
+	r.client = data.client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *indexTypoToleranceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan indexTypoToleranceResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Reset first, as empty lists are not sent by the SDK and would leave existing values in place
+	resp.Diagnostics.Append(r.applyTypoTolerance(ctx, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *indexTypoToleranceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state indexTypoToleranceResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed typo tolerance from Meilisearch
+	typoTolerance, err := r.client.Index(state.IndexUID.ValueString()).GetTypoToleranceWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Reading Meilisearch Index Typo Tolerance",
+				"Could not read typo tolerance of Meilisearch index "+state.IndexUID.ValueString()+": "+apierror.Describe(err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(state.flatten(ctx, typoTolerance)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *indexTypoToleranceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state indexTypoToleranceResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Empty lists are not sent by the SDK, so emptying them requires a reset
+	reset := (len(plan.DisableOnWords.Elements()) == 0 && len(state.DisableOnWords.Elements()) > 0) ||
+		(len(plan.DisableOnAttributes.Elements()) == 0 && len(state.DisableOnAttributes.Elements()) > 0)
+
+	resp.Diagnostics.Append(r.applyTypoTolerance(ctx, &plan, reset)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *indexTypoToleranceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state indexTypoToleranceResourceModel
+
+	diags := req.State.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTaskTimeout)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	task, err := r.client.Index(state.IndexUID.ValueString()).ResetTypoToleranceWithContext(ctx)
+	if err != nil {
+		if apierror.IsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Resetting Meilisearch Index Typo Tolerance",
+			"Could not reset index typo tolerance, unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(waitForTask(ctx, r.client, task)...)
+}
+
+func (r *indexTypoToleranceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import UID and save to index_uid attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("index_uid"), req, resp)
+}
+
+// applyTypoTolerance sends the planned typo tolerance, optionally resetting it
+// beforehand, and waits for the resulting tasks.
+func (r *indexTypoToleranceResource) applyTypoTolerance(ctx context.Context, plan *indexTypoToleranceResourceModel, reset bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	index := r.client.Index(plan.IndexUID.ValueString())
+
+	var minWordSize minWordSizeForTyposModel
+
+	diags.Append(plan.MinWordSizeForTypos.As(ctx, &minWordSize, basetypes.ObjectAsOptions{})...)
+
+	typoTolerance := meilisearch.TypoTolerance{
+		Enabled: plan.Enabled.ValueBool(),
+		MinWordSizeForTypos: meilisearch.MinWordSizeForTypos{
+			OneTypo:  minWordSize.OneTypo.ValueInt64(),
+			TwoTypos: minWordSize.TwoTypos.ValueInt64(),
+		},
+	}
+
+	diags.Append(plan.DisableOnWords.ElementsAs(ctx, &typoTolerance.DisableOnWords, false)...)
+	diags.Append(plan.DisableOnAttributes.ElementsAs(ctx, &typoTolerance.DisableOnAttributes, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if reset {
+		task, err := index.ResetTypoToleranceWithContext(ctx)
+		if err != nil {
+			diags.AddError(
+				"Error Resetting Meilisearch Index Typo Tolerance",
+				"Could not reset index typo tolerance, unexpected error: "+apierror.Describe(err),
+			)
+			return diags
+		}
+
+		diags.Append(waitForTask(ctx, r.client, task)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	task, err := index.UpdateTypoToleranceWithContext(ctx, &typoTolerance)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Meilisearch Index Typo Tolerance",
+			"Could not update index typo tolerance, unexpected error: "+apierror.Describe(err),
+		)
+		return diags
+	}
+
+	diags.Append(waitForTask(ctx, r.client, task)...)
+
+	plan.ID = types.StringValue("placeholder")
+
+	return diags
+}
+
+// flatten sets the model from the typo tolerance read from Meilisearch.
+func (m *indexTypoToleranceResourceModel) flatten(ctx context.Context, typoTolerance *meilisearch.TypoTolerance) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	m.Enabled = types.BoolValue(typoTolerance.Enabled)
+
+	m.MinWordSizeForTypos, d = types.ObjectValueFrom(ctx, minWordSizeForTyposAttrTypes, minWordSizeForTyposModel{
+		OneTypo:  types.Int64Value(typoTolerance.MinWordSizeForTypos.OneTypo),
+		TwoTypos: types.Int64Value(typoTolerance.MinWordSizeForTypos.TwoTypos),
+	})
+	diags.Append(d...)
+
+	m.DisableOnWords, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(typoTolerance.DisableOnWords))
+	diags.Append(d...)
+
+	m.DisableOnAttributes, d = types.SetValueFrom(ctx, types.StringType, nonNilStrings(typoTolerance.DisableOnAttributes))
+	diags.Append(d...)
+
+	m.ID = types.StringValue("placeholder")
+
+	return diags
+}
+
+// minWordSizeForTyposValidator ensures that words need to be at least as long
+// to accept 2 typos as to accept 1 typo. When useDefaults is set, a missing
+// size is compared using its default value.
+type minWordSizeForTyposValidator struct {
+	useDefaults bool
+}
+
+func (v minWordSizeForTyposValidator) Description(_ context.Context) string {
+	return "two_typos must be greater than or equal to one_typo"
+}
+
+func (v minWordSizeForTyposValidator) MarkdownDescription(ctx context.Context) string {
+	return "`two_typos` must be greater than or equal to `one_typo`"
+}
+
+func (v minWordSizeForTyposValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var minWordSize minWordSizeForTyposModel
+
+	resp.Diagnostics.Append(req.ConfigValue.As(ctx, &minWordSize, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || minWordSize.OneTypo.IsUnknown() || minWordSize.TwoTypos.IsUnknown() {
+		return
+	}
+
+	if v.useDefaults {
+		if minWordSize.OneTypo.IsNull() {
+			minWordSize.OneTypo = types.Int64Value(defaultMinWordSizeForOneTypo)
+		}
+		if minWordSize.TwoTypos.IsNull() {
+			minWordSize.TwoTypos = types.Int64Value(defaultMinWordSizeForTwoTypos)
+		}
+	}
+
+	if minWordSize.OneTypo.IsNull() || minWordSize.TwoTypos.IsNull() {
+		return
+	}
+
+	if minWordSize.OneTypo.ValueInt64() > minWordSize.TwoTypos.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Minimum Word Sizes for Typos",
+			fmt.Sprintf("two_typos (%d) must be greater than or equal to one_typo (%d).",
+				minWordSize.TwoTypos.ValueInt64(), minWordSize.OneTypo.ValueInt64()),
+		)
+	}
+}
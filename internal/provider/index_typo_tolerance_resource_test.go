@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexTypoToleranceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "typo-tolerance-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_typo_tolerance" "test" {
+	index_uid = meilisearch_index.test.uid
+	min_word_size_for_typos = {
+		one_typo = 4
+	}
+	disable_on_words = ["shrek"]
+	disable_on_attributes = ["title"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_typo_tolerance.test", "index_uid", "typo-tolerance-index-uid"),
+					resource.TestCheckResourceAttr("meilisearch_index_typo_tolerance.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("meilisearch_index_typo_tolerance.test", "min_word_size_for_typos.one_typo", "4"),
+					resource.TestCheckResourceAttr("meilisearch_index_typo_tolerance.test", "min_word_size_for_typos.two_typos", "9"),
+					resource.TestCheckTypeSetElemAttr("meilisearch_index_typo_tolerance.test", "disable_on_words.*", "shrek"),
+					resource.TestCheckTypeSetElemAttr("meilisearch_index_typo_tolerance.test", "disable_on_attributes.*", "title"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "meilisearch_index_typo_tolerance.test",
+				ImportStateId:                        "typo-tolerance-index-uid",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "index_uid",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "meilisearch_index" "test" {
+	uid = "typo-tolerance-index-uid"
+	primary_key = "id"
+}
+
+resource "meilisearch_index_typo_tolerance" "test" {
+	index_uid = meilisearch_index.test.uid
+	enabled = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("meilisearch_index_typo_tolerance.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("meilisearch_index_typo_tolerance.test", "min_word_size_for_typos.one_typo", "5"),
+					resource.TestCheckResourceAttr("meilisearch_index_typo_tolerance.test", "disable_on_words.#", "0"),
+					resource.TestCheckResourceAttr("meilisearch_index_typo_tolerance.test", "disable_on_attributes.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestMinWordSizeForTyposValidator(t *testing.T) {
+	cases := map[string]struct {
+		oneTypo, twoTypos types.Int64
+		useDefaults       bool
+		expectError       bool
+	}{
+		"valid":                 {oneTypo: types.Int64Value(4), twoTypos: types.Int64Value(8)},
+		"equal":                 {oneTypo: types.Int64Value(6), twoTypos: types.Int64Value(6)},
+		"inverted":              {oneTypo: types.Int64Value(8), twoTypos: types.Int64Value(4), expectError: true},
+		"missing two_typos":     {oneTypo: types.Int64Value(12), twoTypos: types.Int64Null()},
+		"default two_typos":     {oneTypo: types.Int64Value(12), twoTypos: types.Int64Null(), useDefaults: true, expectError: true},
+		"default one_typo":      {oneTypo: types.Int64Null(), twoTypos: types.Int64Value(7), useDefaults: true},
+		"unknown":               {oneTypo: types.Int64Unknown(), twoTypos: types.Int64Value(1)},
+		"default below default": {oneTypo: types.Int64Null(), twoTypos: types.Int64Value(3), useDefaults: true, expectError: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := validator.ObjectRequest{
+				Path: path.Root("min_word_size_for_typos"),
+				ConfigValue: types.ObjectValueMust(minWordSizeForTyposAttrTypes, map[string]attr.Value{
+					"one_typo":  c.oneTypo,
+					"two_typos": c.twoTypos,
+				}),
+			}
+			resp := &validator.ObjectResponse{}
+
+			minWordSizeForTyposValidator{useDefaults: c.useDefaults}.ValidateObject(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != c.expectError {
+				t.Errorf("expected error %t, got diagnostics %v", c.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
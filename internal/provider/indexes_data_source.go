@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"terraform-provider-meilisearch/internal/apierror"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// listPageSize is the number of items requested per page when listing
+// indexes or keys.
+const listPageSize = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                   = &indexesDataSource{}
+	_ datasource.DataSourceWithConfigure      = &indexesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &indexesDataSource{}
+)
+
+func NewIndexesDataSource() datasource.DataSource {
+	return &indexesDataSource{}
+}
+
+// indexesDataSource defines the data source implementation.
+type indexesDataSource struct {
+	client meilisearch.ServiceManager
+}
+
+type indexesDataSourceModel struct {
+	UIDPrefix types.String           `tfsdk:"uid_prefix"`
+	UIDRegex  types.String           `tfsdk:"uid_regex"`
+	Indexes   []indexesDataItemModel `tfsdk:"indexes"`
+	ID        types.String           `tfsdk:"id"`
+}
+
+type indexesDataItemModel struct {
+	UID               types.String `tfsdk:"uid"`
+	PrimaryKey        types.String `tfsdk:"primary_key"`
+	NumberOfDocuments types.Int64  `tfsdk:"number_of_documents"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+}
+
+func (d *indexesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_indexes"
+}
+
+func (d *indexesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves all Meilisearch indexes, optionally filtered by UID.",
+		Attributes: map[string]schema.Attribute{
+			"uid_prefix": schema.StringAttribute{
+				Description: "Only return indexes whose UID starts with this prefix.",
+				Optional:    true,
+			},
+			"uid_regex": schema.StringAttribute{
+				Description: "Only return indexes whose UID matches this regular expression ([RE2 syntax](https://github.com/google/re2/wiki/Syntax)).",
+				Optional:    true,
+			},
+			"indexes": schema.ListNestedAttribute{
+				Description: "Indexes matching the filters, sorted by UID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uid": schema.StringAttribute{
+							Description: "Unique identifier of the index.",
+							Computed:    true,
+						},
+						"primary_key": schema.StringAttribute{
+							Description: "Primary key of the index (`null` if not specified and if no documents have been added yet).",
+							Computed:    true,
+						},
+						"number_of_documents": schema.Int64Attribute{
+							Description: "Number of documents in the index.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Date and time when the index was created (RFC3339)",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Date and time when the index was last updated (RFC3339)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *indexesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var expression types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("uid_regex"), &expression)...)
+	if resp.Diagnostics.HasError() || expression.IsNull() || expression.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(expression.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("uid_regex"),
+			"Invalid Regular Expression",
+			err.Error(),
+		)
+	}
+}
+
+func (d *indexesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state indexesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var expression *regexp.Regexp
+
+	if !state.UIDRegex.IsNull() {
+		expression = regexp.MustCompile(state.UIDRegex.ValueString())
+	}
+
+	// Document counts of every index are returned at once by the global stats
+	stats, err := d.client.GetStatsWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Meilisearch stats",
+			apierror.Describe(err),
+		)
+		return
+	}
+
+	state.Indexes = []indexesDataItemModel{}
+
+	for offset := int64(0); ; offset += listPageSize {
+		page, err := d.client.ListIndexesWithContext(ctx, &meilisearch.IndexesQuery{
+			Limit:  listPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to List Meilisearch indexes",
+				apierror.Describe(err),
+			)
+			return
+		}
+
+		for _, index := range page.Results {
+			if !strings.HasPrefix(index.UID, state.UIDPrefix.ValueString()) {
+				continue
+			}
+
+			if expression != nil && !expression.MatchString(index.UID) {
+				continue
+			}
+
+			state.Indexes = append(state.Indexes, indexesDataItemModel{
+				UID:               types.StringValue(index.UID),
+				PrimaryKey:        stringValueOrNull(index.PrimaryKey),
+				NumberOfDocuments: types.Int64Value(stats.Indexes[index.UID].NumberOfDocuments),
+				CreatedAt:         types.StringValue(index.CreatedAt.Format(time.RFC3339)),
+				UpdatedAt:         types.StringValue(index.UpdatedAt.Format(time.RFC3339)),
+			})
+		}
+
+		if len(page.Results) == 0 || offset+int64(len(page.Results)) >= page.Total {
+			break
+		}
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *indexesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the data source")
+		return
+	}
+
+	d.client = data.client
+}
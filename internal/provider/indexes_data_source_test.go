@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing with a prefix
+			{
+				Config: providerConfig + `
+data "meilisearch_indexes" "test" {
+	uid_prefix = "test_index"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meilisearch_indexes.test", "indexes.#", "2"),
+					resource.TestCheckResourceAttr("data.meilisearch_indexes.test", "indexes.0.uid", "test_index"),
+					resource.TestCheckResourceAttr("data.meilisearch_indexes.test", "indexes.0.primary_key", "test_id"),
+					resource.TestCheckResourceAttr("data.meilisearch_indexes.test", "indexes.0.number_of_documents", "0"),
+					resource.TestCheckResourceAttr("data.meilisearch_indexes.test", "indexes.1.uid", "test_index_no_primary_key"),
+					resource.TestCheckNoResourceAttr("data.meilisearch_indexes.test", "indexes.1.primary_key"),
+					// Verify dates are RFC3339 timestamps
+					resource.TestMatchResourceAttr("data.meilisearch_indexes.test", "indexes.0.created_at", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(Z|[+-]\d{2}:\d{2})$`)),
+					resource.TestMatchResourceAttr("data.meilisearch_indexes.test", "indexes.0.updated_at", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(Z|[+-]\d{2}:\d{2})$`)),
+					// Verify ID placeholder attribute is set
+					resource.TestCheckResourceAttr("data.meilisearch_indexes.test", "id", "placeholder"),
+				),
+			},
+			// Read testing with a regular expression
+			{
+				Config: providerConfig + `
+data "meilisearch_indexes" "test" {
+	uid_regex = "_primary_key$"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meilisearch_indexes.test", "indexes.#", "1"),
+					resource.TestCheckResourceAttr("data.meilisearch_indexes.test", "indexes.0.uid", "test_index_no_primary_key"),
+				),
+			},
+			// Invalid regular expression
+			{
+				Config: providerConfig + `
+data "meilisearch_indexes" "test" {
+	uid_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
+		},
+	})
+}
@@ -2,9 +2,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-meilisearch/internal/apierror"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -13,8 +18,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ datasource.DataSource              = &keyDataSource{}
-	_ datasource.DataSourceWithConfigure = &keyDataSource{}
+	_ datasource.DataSource                     = &keyDataSource{}
+	_ datasource.DataSourceWithConfigure        = &keyDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &keyDataSource{}
 )
 
 func NewKeyDataSource() datasource.DataSource {
@@ -48,11 +54,13 @@ func (d *keyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 		Description: "Manages a Meilisearch API key.",
 		Attributes: map[string]schema.Attribute{
 			"uid": schema.StringAttribute{
-				Description: "UID (uuid v4) used by Meilisearch to identify the key.",
-				Required:    true,
+				Description: "UID (uuid v4) used by Meilisearch to identify the key. Exactly one of `uid` or `name` must be set.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the key.",
+				Description: "Name of the key. Exactly one of `uid` or `name` must be set, and the name must match a single key.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"description": schema.StringAttribute{
@@ -62,6 +70,7 @@ func (d *keyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 			"key": schema.StringAttribute{
 				Description: "Actual key value.",
 				Computed:    true,
+				Sensitive:   true,
 			},
 			"actions": schema.ListAttribute{
 				Description: "Actions permitted for the key.",
@@ -93,25 +102,34 @@ func (d *keyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 	}
 }
 
+func (d *keyDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("uid"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (d *keyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state keyDataSourceModel
 
-	var identifier types.String
+	var identifier, name types.String
 
 	diags := req.Config.GetAttribute(ctx, path.Root("uid"), &identifier)
+	resp.Diagnostics.Append(diags...)
 
+	diags = req.Config.GetAttribute(ctx, path.Root("name"), &name)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	key, err := d.client.GetKey(identifier.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Meilisearch API key",
-			err.Error(),
-		)
+	key, diags := lookupKey(ctx, d.client, identifier, name)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -152,11 +170,72 @@ func (d *keyDataSource) Configure(ctx context.Context, req datasource.ConfigureR
 		return
 	}
 
-	var ok bool
-
-	d.client, ok = req.ProviderData.(meilisearch.ServiceManager)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		tflog.Error(ctx, "Type assertion failed when adding configured client to the data source")
+		return
 	}
+
+	d.client = data.client
+}
+
+// lookupKey retrieves an API key by uid or, when the uid is null, by name,
+// the name being required to match a single key.
+func lookupKey(ctx context.Context, client meilisearch.ServiceManager, identifier, name types.String) (*meilisearch.Key, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var key *meilisearch.Key
+
+	if !identifier.IsNull() {
+		var err error
+
+		key, err = client.GetKeyWithContext(ctx, identifier.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Meilisearch API key",
+				apierror.Describe(err),
+			)
+			return nil, diags
+		}
+	} else {
+		keys, err := listKeys(ctx, client)
+		if err != nil {
+			diags.AddError(
+				"Unable to List Meilisearch API keys",
+				apierror.Describe(err),
+			)
+			return nil, diags
+		}
+
+		var uids []string
+
+		for i := range keys {
+			if keys[i].Name == name.ValueString() {
+				key = &keys[i]
+				uids = append(uids, keys[i].UID)
+			}
+		}
+
+		switch len(uids) {
+		case 0:
+			diags.AddAttributeError(
+				path.Root("name"),
+				"Meilisearch API key Not Found",
+				fmt.Sprintf("No Meilisearch API key is named %q.", name.ValueString()),
+			)
+			return nil, diags
+		case 1:
+		default:
+			diags.AddAttributeError(
+				path.Root("name"),
+				"Multiple Meilisearch API keys Found",
+				fmt.Sprintf("%d Meilisearch API keys are named %q (%s), please look the key up by uid instead.",
+					len(uids), name.ValueString(), strings.Join(uids, ", ")),
+			)
+			return nil, diags
+		}
+	}
+
+	return key, diags
 }
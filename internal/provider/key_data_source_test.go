@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -34,6 +35,27 @@ data "meilisearch_key" "test" {
 					resource.TestCheckResourceAttr("data.meilisearch_key.test", "id", "placeholder"),
 				),
 			},
+			// Read testing by name
+			{
+				Config: providerConfig + `
+data "meilisearch_key" "test" {
+	name = "test_api_key"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meilisearch_key.test", "uid", "11111111-2222-3333-4444-555555555555"),
+					resource.TestCheckResourceAttr("data.meilisearch_key.test", "name", "test_api_key"),
+				),
+			},
+			// Read testing with an unknown name
+			{
+				Config: providerConfig + `
+data "meilisearch_key" "test" {
+	name = "missing_api_key"
+}
+`,
+				ExpectError: regexp.MustCompile("No Meilisearch API key is named"),
+			},
 		},
 	})
 }
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"terraform-provider-meilisearch/internal/apierror"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// keyUIDPrivateKey is the private data key holding the uid of the key created
+// when opening the ephemeral resource.
+const keyUIDPrivateKey = "uid"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource                     = &keyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure        = &keyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose            = &keyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &keyEphemeralResource{}
+)
+
+// NewKeyEphemeralResource is a helper function to simplify the provider implementation.
+func NewKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &keyEphemeralResource{}
+}
+
+// keyEphemeralResource is the ephemeral resource implementation.
+type keyEphemeralResource struct {
+	client meilisearch.ServiceManager
+}
+
+type keyEphemeralResourceModel struct {
+	UID         types.String   `tfsdk:"uid"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Key         types.String   `tfsdk:"key"`
+	Actions     []types.String `tfsdk:"actions"`
+	Indexes     []types.String `tfsdk:"indexes"`
+	ExpiresAt   types.String   `tfsdk:"expires_at"`
+	TTL         types.String   `tfsdk:"ttl"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *keyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *keyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a short-lived Meilisearch API key, revoked once Terraform no longer needs it. " +
+			"The key is never persisted in the plan or state. Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"uid": schema.StringAttribute{
+				Description: "UID (uuid v4) used by Meilisearch to identify the key.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the key.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the key.",
+				Optional:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "Actual key value.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"actions": schema.ListAttribute{
+				Description: "Actions permitted for the key.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"indexes": schema.ListAttribute{
+				Description: "Indexes the key is authorized to act on (with the actions specified in the scope of the key).",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Date and time when the key will expire (RFC3339). Conflicts with `ttl`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ttl": schema.StringAttribute{
+				Description: "Duration after which the key expires, such as `30m` or `2h`, so that it does not outlive " +
+					"an interrupted Terraform run. Conflicts with `expires_at`.",
+				Optional: true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Date and time when the key was created (RFC3339)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *keyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the ephemeral resource")
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *keyEphemeralResource) ConfigValidators(_ context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.Conflicting(
+			path.MatchRoot("expires_at"),
+			path.MatchRoot("ttl"),
+		),
+	}
+}
+
+// Open creates the key.
+func (r *keyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data keyEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createKey := meilisearch.Key{
+		UID:         data.UID.ValueString(),
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}
+
+	for _, action := range data.Actions {
+		createKey.Actions = append(createKey.Actions, action.ValueString())
+	}
+
+	for _, index := range data.Indexes {
+		createKey.Indexes = append(createKey.Indexes, index.ValueString())
+	}
+
+	if !data.ExpiresAt.IsNull() {
+		expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Invalid Expiration Date",
+				"Could not parse expires_at as a RFC3339 date: "+err.Error(),
+			)
+			return
+		}
+
+		createKey.ExpiresAt = expiresAt
+	}
+
+	if !data.TTL.IsNull() {
+		ttl, err := time.ParseDuration(data.TTL.ValueString())
+		if err != nil || ttl <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ttl"),
+				"Invalid Time To Live",
+				"ttl must be a positive duration, such as 30m or 2h.",
+			)
+			return
+		}
+
+		createKey.ExpiresAt = time.Now().UTC().Add(ttl).Truncate(time.Second)
+	}
+
+	key, err := r.client.CreateKeyWithContext(ctx, &createKey)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating key",
+			"Could not create key, unexpected error: "+apierror.Describe(err),
+		)
+		return
+	}
+
+	uid, err := json.Marshal(key.UID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Writing Private Data", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, keyUIDPrivateKey, uid)...)
+
+	data.UID = types.StringValue(key.UID)
+	data.Key = types.StringValue(key.Key)
+	data.CreatedAt = types.StringValue(key.CreatedAt.Format(time.RFC3339))
+
+	if createKey.ExpiresAt.IsZero() {
+		data.ExpiresAt = types.StringNull()
+	} else {
+		data.ExpiresAt = types.StringValue(createKey.ExpiresAt.Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close revokes the key created by Open.
+func (r *keyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, keyUIDPrivateKey)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var uid string
+
+	if err := json.Unmarshal(raw, &uid); err != nil {
+		resp.Diagnostics.AddError("Error Reading Private Data", err.Error())
+		return
+	}
+
+	_, err := r.client.DeleteKeyWithContext(ctx, uid)
+	if err != nil && !apierror.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Meilisearch Key",
+			"Could not revoke key "+uid+", unexpected error: "+apierror.Describe(err),
+		)
+	}
+}
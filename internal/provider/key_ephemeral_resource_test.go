@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testAccProtoV6ProviderFactoriesWithEcho includes the echo provider, which
+// exposes ephemeral values in the state for testing purposes.
+var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+	"meilisearch": providerserver.NewProtocol6WithError(New("dev")()),
+	"echo":        echoprovider.NewProviderServer(),
+}
+
+func TestAccKeyEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+ephemeral "meilisearch_key" "test" {
+	name = "ephemeral_test_key"
+	actions = ["search"]
+	indexes = ["products"]
+	ttl = "10m"
+}
+
+provider "echo" {
+	data = ephemeral.meilisearch_key.test
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact("ephemeral_test_key")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
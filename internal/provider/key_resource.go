@@ -2,7 +2,7 @@ package provider
 
 import (
 	"context"
-	"strings"
+	"terraform-provider-meilisearch/internal/apierror"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -129,13 +129,14 @@ func (r *keyResource) Configure(ctx context.Context, req resource.ConfigureReque
 		return
 	}
 
-	var ok bool
-
-	r.client, ok = req.ProviderData.(meilisearch.ServiceManager)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		tflog.Error(ctx, "Type assertion failed when adding configured client to the resource")
+		return
 	}
+
+	r.client = data.client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -185,12 +186,12 @@ func (r *keyResource) Create(ctx context.Context, req resource.CreateRequest, re
 		ExpiresAt:   expiresAt,
 	}
 
-	key, err := r.client.CreateKey(&createKey)
+	key, err := r.client.CreateKeyWithContext(ctx, &createKey)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating key",
-			"Could not create key, unexpected error: "+err.Error(),
+			"Could not create key, unexpected error: "+apierror.Describe(err),
 		)
 		return
 	}
@@ -227,15 +228,15 @@ func (r *keyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Get refreshed key value from Meilisearch
-	key, err := r.client.GetKey(state.UID.ValueString())
+	key, err := r.client.GetKeyWithContext(ctx, state.UID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "api_key_not_found,") {
+		if apierror.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		} else {
 			resp.Diagnostics.AddError(
 				"Error Reading Meilisearch Key",
-				"Could not read Meilisearch key ID "+state.UID.ValueString()+": "+err.Error(),
+				"Could not read Meilisearch key ID "+state.UID.ValueString()+": "+apierror.Describe(err),
 			)
 			return
 		}
@@ -295,11 +296,11 @@ func (r *keyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	}
 
 	// Update existing key
-	key, err := r.client.UpdateKey(plan.UID.ValueString(), &updateKey)
+	key, err := r.client.UpdateKeyWithContext(ctx, plan.UID.ValueString(), &updateKey)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Meilisearch Key",
-			"Could not update key, unexpected error: "+err.Error(),
+			"Could not update key, unexpected error: "+apierror.Describe(err),
 		)
 		return
 	}
@@ -335,11 +336,11 @@ func (r *keyResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 
 	// Delete existing key
-	_, err := r.client.DeleteKey(state.UID.ValueString())
+	_, err := r.client.DeleteKeyWithContext(ctx, state.UID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Meilisearch Key",
-			"Could not delete key, unexpected error: "+err.Error(),
+			"Could not delete key, unexpected error: "+apierror.Describe(err),
 		)
 		return
 	}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource                     = &keySecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure        = &keySecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &keySecretEphemeralResource{}
+)
+
+// NewKeySecretEphemeralResource is a helper function to simplify the provider implementation.
+func NewKeySecretEphemeralResource() ephemeral.EphemeralResource {
+	return &keySecretEphemeralResource{}
+}
+
+// keySecretEphemeralResource is the ephemeral resource implementation.
+type keySecretEphemeralResource struct {
+	client meilisearch.ServiceManager
+}
+
+type keySecretEphemeralResourceModel struct {
+	UID  types.String `tfsdk:"uid"`
+	Name types.String `tfsdk:"name"`
+	Key  types.String `tfsdk:"key"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (r *keySecretEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_secret"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *keySecretEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the secret of an existing Meilisearch API key without persisting it in the plan or state. " +
+			"Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"uid": schema.StringAttribute{
+				Description: "UID (uuid v4) used by Meilisearch to identify the key. Exactly one of `uid` or `name` must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the key. Exactly one of `uid` or `name` must be set, and the name must match a single key.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "Actual key value.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (r *keySecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the ephemeral resource")
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *keySecretEphemeralResource) ConfigValidators(_ context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("uid"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Open reads the key.
+func (r *keySecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data keySecretEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, diags := lookupKey(ctx, r.client, data.UID, data.Name)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.UID = types.StringValue(key.UID)
+	data.Name = types.StringValue(key.Name)
+	data.Key = types.StringValue(key.Key)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccKeySecretEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+ephemeral "meilisearch_key_secret" "test" {
+	name = "test_api_key"
+}
+
+provider "echo" {
+	data = ephemeral.meilisearch_key_secret.test
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("uid"), knownvalue.StringExact("11111111-2222-3333-4444-555555555555")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"terraform-provider-meilisearch/internal/apierror"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &keysDataSource{}
+	_ datasource.DataSourceWithConfigure = &keysDataSource{}
+)
+
+func NewKeysDataSource() datasource.DataSource {
+	return &keysDataSource{}
+}
+
+// keysDataSource defines the data source implementation.
+type keysDataSource struct {
+	client meilisearch.ServiceManager
+}
+
+type keysDataSourceModel struct {
+	Name        types.String        `tfsdk:"name"`
+	Description types.String        `tfsdk:"description"`
+	Action      types.String        `tfsdk:"action"`
+	Index       types.String        `tfsdk:"index"`
+	Expired     types.Bool          `tfsdk:"expired"`
+	Keys        []keysDataItemModel `tfsdk:"keys"`
+	ID          types.String        `tfsdk:"id"`
+}
+
+type keysDataItemModel struct {
+	UID         types.String   `tfsdk:"uid"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Key         types.String   `tfsdk:"key"`
+	Actions     []types.String `tfsdk:"actions"`
+	Indexes     []types.String `tfsdk:"indexes"`
+	ExpiresAt   types.String   `tfsdk:"expires_at"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	UpdatedAt   types.String   `tfsdk:"updated_at"`
+}
+
+func (d *keysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keys"
+}
+
+func (d *keysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves all Meilisearch API keys, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Only return keys with this exact name.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Only return keys with this exact description.",
+				Optional:    true,
+			},
+			"action": schema.StringAttribute{
+				Description: "Only return keys permitted to perform this action, either explicitly or through a wildcard such as `*` or `documents.*`.",
+				Optional:    true,
+			},
+			"index": schema.StringAttribute{
+				Description: "Only return keys authorized on this index, either explicitly or through a pattern such as `*` or `movies_*`.",
+				Optional:    true,
+			},
+			"expired": schema.BoolAttribute{
+				Description: "Only return expired keys when `true`, or keys that did not expire yet when `false`.",
+				Optional:    true,
+			},
+			"keys": schema.ListNestedAttribute{
+				Description: "Keys matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uid": schema.StringAttribute{
+							Description: "UID (uuid v4) used by Meilisearch to identify the key.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the key.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the key.",
+							Computed:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "Actual key value.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"actions": schema.ListAttribute{
+							Description: "Actions permitted for the key.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"indexes": schema.ListAttribute{
+							Description: "Indexes the key is authorized to act on (with the actions specified in the scope of the key).",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"expires_at": schema.StringAttribute{
+							Description: "Date and time when the key will expire (RFC3339), or `null` for a key that does not expire.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Date and time when the key was created (RFC3339)",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Date and time when the key was last updated (RFC3339)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *keysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state keysDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := listKeys(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Meilisearch API keys",
+			apierror.Describe(err),
+		)
+		return
+	}
+
+	now := time.Now()
+
+	state.Keys = []keysDataItemModel{}
+
+	for _, key := range keys {
+		if !state.Name.IsNull() && key.Name != state.Name.ValueString() {
+			continue
+		}
+
+		if !state.Description.IsNull() && key.Description != state.Description.ValueString() {
+			continue
+		}
+
+		if !state.Action.IsNull() && !keyHasAction(key, state.Action.ValueString()) {
+			continue
+		}
+
+		if !state.Index.IsNull() && !keyHasIndex(key, state.Index.ValueString()) {
+			continue
+		}
+
+		if !state.Expired.IsNull() && keyExpired(key, now) != state.Expired.ValueBool() {
+			continue
+		}
+
+		state.Keys = append(state.Keys, flattenKeysDataItem(key))
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *keysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the data source")
+		return
+	}
+
+	d.client = data.client
+}
+
+// listKeys walks every page of API keys.
+func listKeys(ctx context.Context, client meilisearch.ServiceManager) ([]meilisearch.Key, error) {
+	var keys []meilisearch.Key
+
+	for offset := int64(0); ; offset += listPageSize {
+		page, err := client.GetKeysWithContext(ctx, &meilisearch.KeysQuery{
+			Limit:  listPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, page.Results...)
+
+		if len(page.Results) == 0 || offset+int64(len(page.Results)) >= page.Total {
+			return keys, nil
+		}
+	}
+}
+
+// keyHasAction reports whether a key is permitted to perform an action,
+// taking `*` and `<group>.*` wildcards into account.
+func keyHasAction(key meilisearch.Key, action string) bool {
+	group, _, _ := strings.Cut(action, ".")
+
+	return slices.ContainsFunc(key.Actions, func(allowed string) bool {
+		return allowed == action || allowed == "*" || allowed == group+".*"
+	})
+}
+
+// keyHasIndex reports whether a key is authorized on an index, taking index
+// patterns ending with `*` into account.
+func keyHasIndex(key meilisearch.Key, index string) bool {
+	return slices.ContainsFunc(key.Indexes, func(pattern string) bool {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			return strings.HasPrefix(index, prefix)
+		}
+
+		return pattern == index
+	})
+}
+
+// keyExpired reports whether a key expired, keys without expiration date
+// never expiring.
+func keyExpired(key meilisearch.Key, now time.Time) bool {
+	return !key.ExpiresAt.IsZero() && key.ExpiresAt.Before(now)
+}
+
+// flattenKeysDataItem maps a key to an item of the data source, formatting
+// dates as RFC3339 and leaving expires_at null for keys that never expire.
+func flattenKeysDataItem(key meilisearch.Key) keysDataItemModel {
+	item := keysDataItemModel{
+		UID:         types.StringValue(key.UID),
+		Name:        types.StringValue(key.Name),
+		Description: types.StringValue(key.Description),
+		Key:         types.StringValue(key.Key),
+		ExpiresAt:   types.StringNull(),
+		CreatedAt:   types.StringValue(key.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:   types.StringValue(key.UpdatedAt.Format(time.RFC3339)),
+	}
+
+	if !key.ExpiresAt.IsZero() {
+		item.ExpiresAt = types.StringValue(key.ExpiresAt.Format(time.RFC3339))
+	}
+
+	for _, action := range key.Actions {
+		item.Actions = append(item.Actions, types.StringValue(action))
+	}
+
+	for _, index := range key.Indexes {
+		item.Indexes = append(item.Indexes, types.StringValue(index))
+	}
+
+	return item
+}
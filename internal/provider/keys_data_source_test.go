@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+func TestAccKeysDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `
+data "meilisearch_keys" "test" {
+	name = "test_api_key"
+	action = "documents.add"
+	index = "products"
+	expired = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.meilisearch_keys.test", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.meilisearch_keys.test", "keys.0.uid", "11111111-2222-3333-4444-555555555555"),
+					resource.TestCheckResourceAttr("data.meilisearch_keys.test", "keys.0.description", "Test API key"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_keys.test", "keys.0.key"),
+					// Verify ID placeholder attribute is set
+					resource.TestCheckResourceAttr("data.meilisearch_keys.test", "id", "placeholder"),
+				),
+			},
+			// Read testing without match
+			{
+				Config: providerConfig + `
+data "meilisearch_keys" "test" {
+	name = "test_api_key"
+	action = "search"
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.meilisearch_keys.test", "keys.#", "0"),
+			},
+		},
+	})
+}
+
+func TestKeyFilters(t *testing.T) {
+	now := time.Now()
+
+	key := meilisearch.Key{
+		Actions:   []string{"documents.*", "search"},
+		Indexes:   []string{"movies", "tenant_*"},
+		ExpiresAt: now.Add(-time.Hour),
+	}
+
+	for action, expected := range map[string]bool{
+		"search":           true,
+		"documents.add":    true,
+		"documents.delete": true,
+		"indexes.create":   false,
+	} {
+		if keyHasAction(key, action) != expected {
+			t.Errorf("keyHasAction(%q) should be %t", action, expected)
+		}
+	}
+
+	for index, expected := range map[string]bool{
+		"movies":     true,
+		"movies_old": false,
+		"tenant_1":   true,
+		"tenant":     false,
+	} {
+		if keyHasIndex(key, index) != expected {
+			t.Errorf("keyHasIndex(%q) should be %t", index, expected)
+		}
+	}
+
+	if !keyExpired(key, now) {
+		t.Error("key should be expired")
+	}
+
+	if keyExpired(meilisearch.Key{}, now) {
+		t.Error("key without expiration date should not be expired")
+	}
+}
+
+func TestFlattenKeysDataItem(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	item := flattenKeysDataItem(meilisearch.Key{
+		UID:       "uid",
+		Actions:   []string{"search"},
+		Indexes:   []string{"movies"},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt.Add(time.Hour),
+	})
+
+	if !item.ExpiresAt.IsNull() {
+		t.Errorf("expected a null expires_at for a key that never expires, got %s", item.ExpiresAt)
+	}
+
+	if created := item.CreatedAt.ValueString(); created != "2024-01-02T03:04:05Z" {
+		t.Errorf("expected an RFC3339 created_at, got %q", created)
+	}
+
+	if updated := item.UpdatedAt.ValueString(); updated != "2024-01-02T04:04:05Z" {
+		t.Errorf("expected an RFC3339 updated_at, got %q", updated)
+	}
+
+	item = flattenKeysDataItem(meilisearch.Key{ExpiresAt: createdAt.Add(24 * time.Hour)})
+
+	if expires := item.ExpiresAt.ValueString(); expires != "2024-01-03T03:04:05Z" {
+		t.Errorf("expected an RFC3339 expires_at, got %q", expires)
+	}
+}
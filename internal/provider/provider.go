@@ -6,17 +6,32 @@ import (
 
 	"github.com/meilisearch/meilisearch-go"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure MeilisearchProvider satisfies various provider interfaces.
-var _ provider.Provider = &MeilisearchProvider{}
+var (
+	_ provider.Provider                       = &MeilisearchProvider{}
+	_ provider.ProviderWithEphemeralResources = &MeilisearchProvider{}
+	_ provider.ProviderWithConfigValidators   = &MeilisearchProvider{}
+	_ provider.ProviderWithFunctions          = &MeilisearchProvider{}
+)
 
 // MeilisearchProvider defines the provider implementation.
 type MeilisearchProvider struct {
@@ -28,8 +43,25 @@ type MeilisearchProvider struct {
 
 // MeilisearchProviderModel describes the provider data model.
 type MeilisearchProviderModel struct {
-	Host   types.String `tfsdk:"host"`
-	ApiKey types.String `tfsdk:"api_key"`
+	Host               types.String `tfsdk:"host"`
+	ApiKey             types.String `tfsdk:"api_key"`
+	ApiKeyFile         types.String `tfsdk:"api_key_file"`
+	ApiKeyCommand      types.List   `tfsdk:"api_key_command"`
+	CheckConnectivity  types.Bool   `tfsdk:"check_connectivity"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	Headers            types.Map    `tfsdk:"headers"`
+
+	RequestTimeout       types.String  `tfsdk:"request_timeout"`
+	MaxRetries           types.Int64   `tfsdk:"max_retries"`
+	RetryBackoff         types.String  `tfsdk:"retry_backoff"`
+	RetryMaxBackoff      types.String  `tfsdk:"retry_max_backoff"`
+	RetryableStatusCodes types.Set     `tfsdk:"retryable_status_codes"`
+	MaxRequestsPerSecond types.Float64 `tfsdk:"max_requests_per_second"`
 }
 
 func (p *MeilisearchProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -45,14 +77,134 @@ func (p *MeilisearchProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Optional:    true,
 			},
 			"api_key": schema.StringAttribute{
-				Description: "Meilisearch master API key. May also be provided via MEILISEARCH_API_KEY environment variable.",
+				Description: "Meilisearch master API key. Conflicts with `api_key_file` and `api_key_command`. " +
+					"May also be provided via MEILISEARCH_API_KEY environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"api_key_file": schema.StringAttribute{
+				Description: "Path to a file containing the Meilisearch master API key, surrounding whitespace being ignored. " +
+					"Conflicts with `api_key` and `api_key_command`. May also be provided via MEILISEARCH_API_KEY_FILE environment variable.",
+				Optional: true,
+			},
+			"api_key_command": schema.ListAttribute{
+				Description: "Command printing the Meilisearch master API key on its standard output, such as a credential helper, " +
+					"given as the program followed by its arguments and run without a shell. Conflicts with `api_key` and `api_key_file`. " +
+					"May also be provided via MEILISEARCH_API_KEY_COMMAND environment variable, arguments being separated by spaces.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"check_connectivity": schema.BoolAttribute{
+				Description: "Whether to check during provider configuration that the Meilisearch server is healthy and accepts the API key, " +
+					"reporting unreachable hosts, TLS failures and missing keys before any resource is read, and warning about rejected keys. Defaults to `false`. " +
+					"May also be provided via MEILISEARCH_CHECK_CONNECTIVITY environment variable.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates trusted in addition to the system ones when connecting to the Meilisearch server. " +
+					"Conflicts with `ca_cert_file`. May also be provided via MEILISEARCH_CA_CERT_PEM environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file containing PEM encoded CA certificates trusted in addition to the system ones when connecting to the Meilisearch server. " +
+					"May also be provided via MEILISEARCH_CA_CERT_FILE environment variable.",
+				Optional: true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM encoded client certificate presented to the Meilisearch server for mutual TLS authentication. " +
+					"Requires `client_key`. May also be provided via MEILISEARCH_CLIENT_CERT environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate. " +
+					"Requires `client_cert`. May also be provided via MEILISEARCH_CLIENT_KEY environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Whether to skip the verification of the Meilisearch server certificate. Only use it for testing. Defaults to `false`. " +
+					"May also be provided via MEILISEARCH_INSECURE_SKIP_VERIFY environment variable.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy used to connect to the Meilisearch server. Defaults to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables. " +
+					"May also be provided via MEILISEARCH_PROXY_URL environment variable.",
+				Optional: true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "HTTP headers added to every request sent to the Meilisearch server, for instance to authenticate with an ingress. " +
+					"Headers set by the client, such as `Authorization`, cannot be overridden. " +
+					"May also be provided via MEILISEARCH_HEADERS environment variable, as a JSON object.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout of every request sent to the Meilisearch server, as a duration such as `30s`, each retry having its own timeout. " +
+					"Defaults to no timeout. May also be provided via MEILISEARCH_REQUEST_TIMEOUT environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Number of times a failed request is retried, `0` disabling retries. Requests are retried when the server cannot be reached " +
+					"or answers with one of the `retryable_status_codes`, except for requests that are not idempotent, such as the creation of an API key, " +
+					"which are only retried when the connection failed before they were sent. Defaults to `3`. May also be provided via MEILISEARCH_MAX_RETRIES environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_backoff": schema.StringAttribute{
+				Description: "Delay before the first retry, doubled on every following retry, as a duration such as `500ms`. " +
+					"Defaults to `500ms`. May also be provided via MEILISEARCH_RETRY_BACKOFF environment variable.",
+				Optional: true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Description: "Maximum delay between two retries, also bounding the delay requested by a `Retry-After` header, as a duration such as `30s`. " +
+					"Defaults to `30s`. May also be provided via MEILISEARCH_RETRY_MAX_BACKOFF environment variable.",
+				Optional: true,
+			},
+			"retryable_status_codes": schema.SetAttribute{
+				Description: "HTTP status codes for which requests are retried. Defaults to `429`, `502`, `503` and `504`. " +
+					"May also be provided via MEILISEARCH_RETRYABLE_STATUS_CODES environment variable, as a comma-separated list.",
+				ElementType: types.Int64Type,
 				Optional:    true,
-				Sensitive:   true,
+				Validators: []validator.Set{
+					setvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+				},
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of requests sent to the Meilisearch server per second, shared by all resources. " +
+					"Defaults to no limit. May also be provided via MEILISEARCH_MAX_REQUESTS_PER_SECOND environment variable.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
 			},
 		},
 	}
 }
 
+func (p *MeilisearchProvider) ConfigValidators(_ context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("api_key"),
+			path.MatchRoot("api_key_file"),
+			path.MatchRoot("api_key_command"),
+		),
+	}
+}
+
 func (p *MeilisearchProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring Meilisearch client")
 
@@ -82,6 +234,34 @@ func (p *MeilisearchProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
+	for attribute, value := range map[string]attr.Value{
+		"api_key_file":            config.ApiKeyFile,
+		"api_key_command":         config.ApiKeyCommand,
+		"check_connectivity":      config.CheckConnectivity,
+		"ca_cert_pem":             config.CACertPEM,
+		"ca_cert_file":            config.CACertFile,
+		"client_cert":             config.ClientCert,
+		"client_key":              config.ClientKey,
+		"insecure_skip_verify":    config.InsecureSkipVerify,
+		"proxy_url":               config.ProxyURL,
+		"headers":                 config.Headers,
+		"request_timeout":         config.RequestTimeout,
+		"max_retries":             config.MaxRetries,
+		"retry_backoff":           config.RetryBackoff,
+		"retry_max_backoff":       config.RetryMaxBackoff,
+		"retryable_status_codes":  config.RetryableStatusCodes,
+		"max_requests_per_second": config.MaxRequestsPerSecond,
+	} {
+		if value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Unknown Meilisearch client setting",
+				"The provider cannot create the Meilisearch API client as there is an unknown configuration value for "+attribute+". "+
+					"Either target apply the source of the value first, set the value statically in the configuration, or use its environment variable.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -90,15 +270,20 @@ func (p *MeilisearchProvider) Configure(ctx context.Context, req provider.Config
 	// with Terraform configuration value if set.
 
 	host := os.Getenv("MEILISEARCH_HOST")
-	apiKey := os.Getenv("MEILISEARCH_API_KEY")
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
 	}
 
-	if !config.ApiKey.IsNull() {
-		apiKey = config.ApiKey.ValueString()
-	}
+	apiKey, diags := resolveAPIKey(ctx, config)
+
+	resp.Diagnostics.Append(diags...)
+
+	checkConnectivity := configuredBool(config.CheckConnectivity, "check_connectivity", "MEILISEARCH_CHECK_CONNECTIVITY", &resp.Diagnostics)
+
+	transport, diags := newTransportConfig(ctx, config)
+
+	resp.Diagnostics.Append(diags...)
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
@@ -113,12 +298,13 @@ func (p *MeilisearchProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
-	if apiKey == "" {
+	if apiKey == "" && !resp.Diagnostics.HasError() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
 			"Missing Meilisearch API key",
 			"The provider cannot create the Meilisearch API client as there is a missing or empty value for the Meilisearch API key. "+
-				"Set the API key value in the configuration or use the MEILISEARCH_API_KEY environment variable. "+
+				"Set the api_key, api_key_file or api_key_command value in the configuration or use the MEILISEARCH_API_KEY, "+
+				"MEILISEARCH_API_KEY_FILE or MEILISEARCH_API_KEY_COMMAND environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -133,13 +319,33 @@ func (p *MeilisearchProvider) Configure(ctx context.Context, req provider.Config
 
 	tflog.Debug(ctx, "Creating Meilisearch client")
 
-	// Create a new Meilisearch client using the configuration values
-	client := meilisearch.New(host, meilisearch.WithAPIKey(apiKey))
+	httpClient, diags := newHTTPClient(transport)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create a new Meilisearch client using the configuration values,
+	// requests being retried by the HTTP client (see retryTransport)
+	client := meilisearch.New(host, meilisearch.WithAPIKey(apiKey), meilisearch.WithCustomClient(httpClient), meilisearch.DisableRetries())
 
-	// Make the Meilisearch client available during DataSource and Resource
-	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	if checkConnectivity {
+		tflog.Debug(ctx, "Checking Meilisearch connectivity")
+
+		resp.Diagnostics.Append(verifyConnectivity(ctx, client, host)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Make the Meilisearch client and server version available during
+	// DataSource, Resource and EphemeralResource type Configure methods.
+	data := newProviderData(ctx, client)
+
+	resp.DataSourceData = data
+	resp.ResourceData = data
+	resp.EphemeralResourceData = data
 
 	tflog.Info(ctx, "Configured Meilisearch client", map[string]any{"success": true})
 }
@@ -148,14 +354,46 @@ func (p *MeilisearchProvider) Resources(ctx context.Context) []func() resource.R
 	return []func() resource.Resource{
 		NewKeyResource,
 		NewIndexResource,
+		NewIndexSettingsResource,
+		NewIndexEmbeddersResource,
+		NewIndexTypoToleranceResource,
+		NewIndexSynonymsResource,
+		NewIndexRankingRulesResource,
+		NewIndexFacetingResource,
+		NewIndexPaginationResource,
+		NewIndexTokenizationResource,
+		NewIndexLocalizedAttributesResource,
+		NewIndexProximityPrecisionResource,
+		NewIndexPerformanceSettingsResource,
+		NewDocumentsResource,
+		NewDocumentsFileResource,
 	}
 }
 
 func (p *MeilisearchProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewKeyDataSource,
+		NewKeysDataSource,
 		NewIndexDataSource,
+		NewIndexSettingsDataSource,
+		NewIndexStatsDataSource,
+		NewIndexesDataSource,
 		NewVersionDataSource,
+		NewStatsDataSource,
+		NewHealthDataSource,
+	}
+}
+
+func (p *MeilisearchProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewKeyEphemeralResource,
+		NewKeySecretEphemeralResource,
+	}
+}
+
+func (p *MeilisearchProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewTenantTokenFunction,
 	}
 }
 
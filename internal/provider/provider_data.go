@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// providerData is shared by the provider with resources, data sources and
+// ephemeral resources through their Configure method.
+type providerData struct {
+	client meilisearch.ServiceManager
+
+	// rawVersion and version describe the Meilisearch server, fetched once
+	// when configuring the provider. versionErr is set instead when the
+	// version could not be read, for instance with a key restricted to other
+	// actions: only features requiring a minimum version then fail.
+	rawVersion string
+	version    serverVersion
+	versionErr error
+}
+
+// newProviderData fetches the version of the Meilisearch server and bundles
+// it with the client.
+func newProviderData(ctx context.Context, client meilisearch.ServiceManager) *providerData {
+	data := &providerData{client: client}
+
+	version, err := client.VersionWithContext(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read Meilisearch version", map[string]any{"error": apierror.Describe(err)})
+
+		data.versionErr = err
+		return data
+	}
+
+	data.rawVersion = version.PkgVersion
+	data.version, data.versionErr = parseServerVersion(version.PkgVersion)
+
+	tflog.Debug(ctx, "Read Meilisearch version", map[string]any{"version": version.PkgVersion})
+
+	return data
+}
+
+// supports reports whether the server is known to be at least the minimum
+// version, for features that can fall back to another implementation.
+func (d *providerData) supports(minimum serverVersion) bool {
+	return d != nil && d.versionErr == nil && d.version.atLeast(minimum)
+}
+
+// olderThan reports whether the server is known to be older than the minimum
+// version, for settings that are left out on older servers.
+func (d *providerData) olderThan(minimum serverVersion) bool {
+	return d != nil && d.versionErr == nil && !d.version.atLeast(minimum)
+}
+
+// requireServerVersionForPlan is requireServerVersion for ModifyPlan, so that
+// plans creating a resource or changing the given attributes fail early on
+// older servers. Nothing is checked when destroying the resource, when the
+// attributes keep their current value, or before the provider is configured.
+func (d *providerData) requireServerVersionForPlan(ctx context.Context, req resource.ModifyPlanRequest, feature string, minimum serverVersion, attributes ...path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d == nil || req.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	if !req.State.Raw.IsNull() {
+		changed := false
+
+		for _, attribute := range attributes {
+			var planned, current attr.Value
+
+			diags.Append(req.Plan.GetAttribute(ctx, attribute, &planned)...)
+			diags.Append(req.State.GetAttribute(ctx, attribute, &current)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if !planned.Equal(current) {
+				changed = true
+			}
+		}
+
+		if !changed {
+			return diags
+		}
+	}
+
+	diags.Append(d.requireServerVersion(feature, minimum)...)
+
+	return diags
+}
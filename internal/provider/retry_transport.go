@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries      = 3
+	defaultRetryBackoff    = 500 * time.Millisecond
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// defaultRetryableStatusCodes are the statuses returned by Meilisearch or a
+// load balancer in front of it when the request may succeed later.
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// readOnlyPostSuffixes are the paths of the POST routes of Meilisearch that
+// only read data, and can be sent again like a GET.
+var readOnlyPostSuffixes = []string{
+	"/documents/fetch",
+	"/search",
+	"/multi-search",
+}
+
+// retryTransport retries failed requests with an exponential backoff and
+// limits the rate of requests sent to the server. It replaces the retries of
+// the SDK, which do not back off exponentially nor log attempts.
+type retryTransport struct {
+	base                 http.RoundTripper
+	requestTimeout       time.Duration
+	maxRetries           int
+	backoff              time.Duration
+	maxBackoff           time.Duration
+	retryableStatusCodes map[int]bool
+	limiter              *rateLimiter
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.maxRetries > 0 {
+		var err error
+
+		req, err = replayable(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if t.limiter != nil {
+			if err := t.limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		attemptReq := req
+
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.roundTrip(attemptReq)
+
+		if attempt >= t.maxRetries || !t.retryable(req, resp, err) {
+			return resp, err
+		}
+
+		delay := t.delay(attempt, resp)
+
+		fields := map[string]any{
+			"method":      req.Method,
+			"path":        req.URL.Path,
+			"attempt":     attempt + 1,
+			"max_retries": t.maxRetries,
+			"delay":       delay.String(),
+		}
+
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status_code"] = resp.StatusCode
+
+			// Release the connection before waiting
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		tflog.Warn(ctx, "Retrying Meilisearch request", fields)
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// roundTrip sends a single attempt, bounded by the request timeout.
+func (t *retryTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	if t.requestTimeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.requestTimeout)
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The timeout also covers reading the body
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// retryable reports whether the attempt failed and can be sent again: the
+// request was not cancelled, and it either failed
+// without a response or got a retryable status. Requests that are not
+// idempotent, such as the creation of a key or a document addition, are only
+// sent again when the connection failed before they were written, since the
+// server may otherwise have processed them already.
+func (t *retryTransport) retryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return idempotent(req) || notSent(err)
+	}
+
+	return idempotent(req) && t.retryableStatusCodes[resp.StatusCode]
+}
+
+// idempotent reports whether sending the request several times has the same
+// effect as sending it once.
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		for _, suffix := range readOnlyPostSuffixes {
+			if strings.HasSuffix(req.URL.Path, suffix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// notSent reports whether the attempt failed while connecting to the server
+// or to the proxy, before the request was written.
+func notSent(err error) bool {
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr) && (opErr.Op == "dial" || opErr.Op == "proxyconnect")
+}
+
+// replayable returns a copy of req whose body can be sent again on every
+// attempt. The SDK wraps bodies in a reader that http.NewRequest cannot
+// replay, so the body is buffered when GetBody is not set.
+func replayable(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return req, nil
+	}
+
+	content, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(content))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+
+	return req, nil
+}
+
+// delay returns the time to wait before the next attempt, doubling the
+// backoff on every attempt and honoring the Retry-After header, within the
+// maximum backoff.
+func (t *retryTransport) delay(attempt int, resp *http.Response) time.Duration {
+	delay := t.backoff
+	for i := 0; i < attempt && delay < t.maxBackoff; i++ {
+		delay *= 2
+	}
+
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			delay = max(delay, time.Duration(seconds)*time.Second)
+		}
+	}
+
+	return min(delay, t.maxBackoff)
+}
+
+// cancelOnCloseBody releases the context of an attempt once its response
+// body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close()
+}
+
+// rateLimiter spaces requests evenly to stay under a number of requests per
+// second, shared by all the resources of the provider.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / requestsPerSecond)}
+}
+
+// wait blocks until the next request is allowed or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(at)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// newRetryTestServer starts a server answering the given statuses in turn, and
+// 200 (202 for settings updates) with the request body (or an empty JSON
+// object) once they are exhausted.
+func newRetryTestServer(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := int(attempts.Add(1))
+		if attempt <= len(statuses) {
+			w.WriteHeader(statuses[attempt-1])
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		if len(body) == 0 {
+			body = []byte(`{}`)
+		}
+
+		if r.Method == http.MethodPatch {
+			w.WriteHeader(http.StatusAccepted)
+		}
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &attempts
+}
+
+func newTestRetryTransport(maxRetries int) *retryTransport {
+	return &retryTransport{
+		base:                 http.DefaultTransport,
+		maxRetries:           maxRetries,
+		backoff:              time.Millisecond,
+		maxBackoff:           10 * time.Millisecond,
+		retryableStatusCodes: map[int]bool{http.StatusBadGateway: true, http.StatusServiceUnavailable: true},
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	testCases := map[string]struct {
+		method           string
+		path             string
+		statuses         []int
+		maxRetries       int
+		expectedStatus   int
+		expectedAttempts int32
+	}{
+		"success": {
+			method:           http.MethodPut,
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 1,
+		},
+		"retryable statuses": {
+			method:           http.MethodPut,
+			statuses:         []int{http.StatusBadGateway, http.StatusServiceUnavailable},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		"retries exhausted": {
+			method:           http.MethodPut,
+			statuses:         []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxRetries:       2,
+			expectedStatus:   http.StatusBadGateway,
+			expectedAttempts: 3,
+		},
+		"retries disabled": {
+			method:           http.MethodPut,
+			statuses:         []int{http.StatusBadGateway},
+			maxRetries:       0,
+			expectedStatus:   http.StatusBadGateway,
+			expectedAttempts: 1,
+		},
+		"non retryable status": {
+			method:           http.MethodPut,
+			statuses:         []int{http.StatusBadRequest},
+			maxRetries:       3,
+			expectedStatus:   http.StatusBadRequest,
+			expectedAttempts: 1,
+		},
+		"post not retried": {
+			method:           http.MethodPost,
+			path:             "/keys",
+			statuses:         []int{http.StatusBadGateway},
+			maxRetries:       3,
+			expectedStatus:   http.StatusBadGateway,
+			expectedAttempts: 1,
+		},
+		"read-only post": {
+			method:           http.MethodPost,
+			path:             "/indexes/movies/documents/fetch",
+			statuses:         []int{http.StatusBadGateway},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server, attempts := newRetryTestServer(t, testCase.statuses...)
+			client := &http.Client{Transport: newTestRetryTransport(testCase.maxRetries)}
+
+			req, err := http.NewRequest(testCase.method, server.URL+testCase.path, strings.NewReader(`{"uid": "movies"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			body, _ := io.ReadAll(resp.Body)
+
+			if resp.StatusCode != testCase.expectedStatus {
+				t.Errorf("expected status %d, got %d", testCase.expectedStatus, resp.StatusCode)
+			}
+
+			if got := attempts.Load(); got != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", testCase.expectedAttempts, got)
+			}
+
+			// The body is sent again on every attempt
+			if resp.StatusCode == http.StatusOK && string(body) != `{"uid": "movies"}` {
+				t.Errorf("unexpected body %q", body)
+			}
+		})
+	}
+}
+
+// countingTransport counts the attempts of requests failing before reaching
+// any server.
+type countingTransport struct {
+	base     http.RoundTripper
+	attempts atomic.Int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.attempts.Add(1)
+
+	return t.base.RoundTrip(req)
+}
+
+func TestRetryTransportNotSent(t *testing.T) {
+	// Nothing listens on the address of a closed server
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	base := &countingTransport{base: http.DefaultTransport}
+	transport := newTestRetryTransport(2)
+	transport.base = base
+
+	resp, err := (&http.Client{Transport: transport}).Post(server.URL+"/keys", "application/json", strings.NewReader(`{"actions": ["*"]}`))
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("expected a connection error")
+	}
+
+	// The connection was refused before the request was written
+	if got := base.attempts.Load(); got != 3 {
+		t.Errorf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestRetryTransportRequestTimeout(t *testing.T) {
+	var attempts atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Only the first attempt is too slow
+		if attempts.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	transport := newTestRetryTransport(1)
+	transport.requestTimeout = 50 * time.Millisecond
+
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestRetryTransportCancelled(t *testing.T) {
+	server, attempts := newRetryTestServer(t, http.StatusBadGateway, http.StatusBadGateway)
+
+	transport := newTestRetryTransport(3)
+	transport.backoff = time.Minute
+	transport.maxBackoff = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := (&http.Client{Transport: transport}).Do(req); err == nil {
+		t.Error("expected an error once the context is done")
+	}
+
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("expected 1 attempt, got %d", got)
+	}
+}
+
+func TestRetryTransportDelay(t *testing.T) {
+	transport := &retryTransport{backoff: 500 * time.Millisecond, maxBackoff: 5 * time.Second}
+
+	retryAfter := func(value string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": []string{value}}}
+	}
+
+	testCases := map[string]struct {
+		attempt  int
+		resp     *http.Response
+		expected time.Duration
+	}{
+		"first retry":         {attempt: 0, expected: 500 * time.Millisecond},
+		"third retry":         {attempt: 2, expected: 2 * time.Second},
+		"maximum backoff":     {attempt: 10, expected: 5 * time.Second},
+		"retry after":         {attempt: 0, resp: retryAfter("2"), expected: 2 * time.Second},
+		"retry after bounded": {attempt: 0, resp: retryAfter("60"), expected: 5 * time.Second},
+		"retry after date":    {attempt: 1, resp: retryAfter("Wed, 21 Oct 2015 07:28:00 GMT"), expected: time.Second},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if delay := transport.delay(testCase.attempt, testCase.resp); delay != testCase.expected {
+				t.Errorf("expected delay %s, got %s", testCase.expected, delay)
+			}
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter(20)
+
+	start := time.Now()
+
+	for range 3 {
+		if err := limiter.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The first request is immediate, the next ones are spaced by 50ms
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("expected 3 requests to take at least 100ms, took %s", elapsed)
+	}
+}
+
+func TestRetryTransportThroughClient(t *testing.T) {
+	server, attempts := newRetryTestServer(t, http.StatusBadGateway, http.StatusServiceUnavailable)
+
+	httpClient, diags := newHTTPClient(transportConfig{
+		maxRetries:           2,
+		retryBackoff:         time.Millisecond,
+		retryMaxBackoff:      time.Millisecond,
+		retryableStatusCodes: defaultRetryableStatusCodes,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	client := meilisearch.New(server.URL, meilisearch.WithAPIKey("key"), meilisearch.WithCustomClient(httpClient), meilisearch.DisableRetries())
+
+	if _, err := client.GetIndexWithContext(context.Background(), "movies"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := attempts.Load(); got != 3 {
+		t.Errorf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestRetryTransportThroughClientWithBody(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		send             func(meilisearch.ServiceManager) error
+		expectedAttempts int32
+	}{
+		"update settings": {
+			send: func(client meilisearch.ServiceManager) error {
+				_, err := client.Index("movies").UpdateSettingsWithContext(ctx, &meilisearch.Settings{RankingRules: []string{"words"}})
+				return err
+			},
+			expectedAttempts: 2,
+		},
+		"fetch documents": {
+			send: func(client meilisearch.ServiceManager) error {
+				var result meilisearch.DocumentsResult
+
+				if err := client.Index("movies").GetDocumentsWithContext(ctx, &meilisearch.DocumentsQuery{Ids: []string{"1"}, Limit: 2}, &result); err != nil {
+					return err
+				}
+
+				// The server echoes the body of the last attempt
+				if result.Limit != 2 {
+					t.Errorf("expected the body to be sent again, got limit %d", result.Limit)
+				}
+				return nil
+			},
+			expectedAttempts: 2,
+		},
+		"create index": {
+			send: func(client meilisearch.ServiceManager) error {
+				_, err := client.CreateIndexWithContext(ctx, &meilisearch.IndexConfig{Uid: "movies"})
+				if err == nil {
+					t.Error("expected the 502 to be returned")
+				}
+				return nil
+			},
+			// The index may have been created already
+			expectedAttempts: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server, attempts := newRetryTestServer(t, http.StatusBadGateway)
+
+			httpClient, diags := newHTTPClient(transportConfig{
+				maxRetries:           2,
+				retryBackoff:         time.Millisecond,
+				retryMaxBackoff:      time.Millisecond,
+				retryableStatusCodes: defaultRetryableStatusCodes,
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			client := meilisearch.New(server.URL, meilisearch.WithAPIKey("key"), meilisearch.WithCustomClient(httpClient), meilisearch.DisableRetries())
+
+			if err := testCase.send(client); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := attempts.Load(); got != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", testCase.expectedAttempts, got)
+			}
+		})
+	}
+}
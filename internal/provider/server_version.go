@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-meilisearch/internal/apierror"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// serverVersion is the major and minor version of a Meilisearch server.
+type serverVersion struct {
+	major, minor int
+}
+
+// parseServerVersion parses versions such as `1.10.2` or `v1.13.0-rc.1`,
+// ignoring the patch version and any pre-release suffix.
+func parseServerVersion(version string) (serverVersion, error) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return serverVersion{}, fmt.Errorf("invalid version %q", version)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return serverVersion{}, fmt.Errorf("invalid major version in %q", version)
+	}
+
+	minor, err := strconv.Atoi(strings.SplitN(parts[1], "-", 2)[0])
+	if err != nil {
+		return serverVersion{}, fmt.Errorf("invalid minor version in %q", version)
+	}
+
+	return serverVersion{major: major, minor: minor}, nil
+}
+
+// atLeast reports whether the version is greater than or equal to minimum.
+func (v serverVersion) atLeast(minimum serverVersion) bool {
+	return v.major > minimum.major || (v.major == minimum.major && v.minor >= minimum.minor)
+}
+
+func (v serverVersion) String() string {
+	return fmt.Sprintf("%d.%d", v.major, v.minor)
+}
+
+// requireServerVersion reports an error when the Meilisearch server is older
+// than the minimum version supporting the given feature, relying on the
+// version fetched when configuring the provider.
+func (d *providerData) requireServerVersion(feature string, minimum serverVersion) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The key may not be allowed to read the version, in which case older
+	// servers only reject the feature when applying
+	if d.versionErr != nil {
+		diags.AddWarning(
+			"Unable to Read Meilisearch Version",
+			"Could not check that the server supports "+feature+": "+apierror.Describe(d.versionErr),
+		)
+		return diags
+	}
+
+	if !d.version.atLeast(minimum) {
+		diags.AddError(
+			"Unsupported Meilisearch Version",
+			fmt.Sprintf("%s requires Meilisearch >= %s, server is %s.", feature, minimum, d.rawVersion),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+func newVersionTestServer(t *testing.T, version string) meilisearch.ServiceManager {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"commitSha": "abc", "commitDate": "2024-01-01T00:00:00Z", "pkgVersion": "` + version + `"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return meilisearch.New(server.URL, meilisearch.WithAPIKey("key"))
+}
+
+func TestParseServerVersion(t *testing.T) {
+	cases := map[string]serverVersion{
+		"1.10.2":       {major: 1, minor: 10},
+		"v1.13.0-rc.1": {major: 1, minor: 13},
+		"1.9":          {major: 1, minor: 9},
+		"2.0.0":        {major: 2, minor: 0},
+	}
+
+	for version, expected := range cases {
+		parsed, err := parseServerVersion(version)
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %s", version, err)
+		} else if parsed != expected {
+			t.Errorf("expected %v for %q, got %v", expected, version, parsed)
+		}
+	}
+
+	for _, version := range []string{"", "1", "one.two", "1.x.0"} {
+		if _, err := parseServerVersion(version); err == nil {
+			t.Errorf("expected error parsing %q", version)
+		}
+	}
+}
+
+func TestServerVersionAtLeast(t *testing.T) {
+	minimum := serverVersion{major: 1, minor: 10}
+
+	for version, expected := range map[serverVersion]bool{
+		{major: 1, minor: 9}:  false,
+		{major: 1, minor: 10}: true,
+		{major: 1, minor: 13}: true,
+		{major: 2, minor: 0}:  true,
+		{major: 0, minor: 30}: false,
+	} {
+		if version.atLeast(minimum) != expected {
+			t.Errorf("expected %s >= %s to be %t", version, minimum, expected)
+		}
+	}
+}
+
+func TestRequireServerVersion(t *testing.T) {
+	ctx := context.Background()
+	minimum := serverVersion{major: 1, minor: 10}
+
+	diags := newProviderData(ctx, newVersionTestServer(t, "1.10.0")).requireServerVersion("Localized attributes", minimum)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	diags = newProviderData(ctx, newVersionTestServer(t, "1.9.1")).requireServerVersion("Localized attributes", minimum)
+	if !diags.HasError() {
+		t.Fatal("expected an error for an older server")
+	}
+
+	if detail := diags[0].Detail(); !strings.Contains(detail, "requires Meilisearch >= 1.10, server is 1.9.1") {
+		t.Errorf("unexpected error detail: %s", detail)
+	}
+}
+
+func TestRequireServerVersionUnreadable(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "The provided API key is invalid.", "code": "invalid_api_key", "type": "auth", "link": ""}`))
+	}))
+	t.Cleanup(server.Close)
+
+	data := newProviderData(context.Background(), meilisearch.New(server.URL, meilisearch.WithAPIKey("key"), meilisearch.DisableRetries()))
+
+	for range 2 {
+		diags := data.requireServerVersion("Localized attributes", serverVersion{major: 1, minor: 10})
+		if diags.HasError() {
+			t.Fatalf("unexpected error when the version is unknown: %v", diags)
+		}
+
+		if len(diags) != 1 || diags[0].Summary() != "Unable to Read Meilisearch Version" {
+			t.Errorf("expected a warning, got %v", diags)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("expected the version to be fetched once, got %d requests", requests)
+	}
+}
+
+func TestRequireServerVersionForPlan(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"index_uid":           schema.StringAttribute{Required: true},
+			"proximity_precision": schema.StringAttribute{Required: true},
+		},
+	}
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"index_uid":           tftypes.String,
+		"proximity_precision": tftypes.String,
+	}}
+
+	value := func(proximityPrecision string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"index_uid":           tftypes.NewValue(tftypes.String, "movies"),
+			"proximity_precision": tftypes.NewValue(tftypes.String, proximityPrecision),
+		})
+	}
+
+	null := tftypes.NewValue(objectType, nil)
+
+	testCases := map[string]struct {
+		data            *providerData
+		state           tftypes.Value
+		plan            tftypes.Value
+		expectedError   bool
+		expectedWarning bool
+	}{
+		"create on older server": {
+			data:          &providerData{version: serverVersion{major: 1, minor: 5}, rawVersion: "1.5.1"},
+			state:         null,
+			plan:          value("byAttribute"),
+			expectedError: true,
+		},
+		"create on newer server": {
+			data:  &providerData{version: serverVersion{major: 1, minor: 6}, rawVersion: "1.6.0"},
+			state: null,
+			plan:  value("byAttribute"),
+		},
+		"update on older server": {
+			data:          &providerData{version: serverVersion{major: 1, minor: 5}, rawVersion: "1.5.1"},
+			state:         value("byWord"),
+			plan:          value("byAttribute"),
+			expectedError: true,
+		},
+		"unchanged on older server": {
+			data:  &providerData{version: serverVersion{major: 1, minor: 5}, rawVersion: "1.5.1"},
+			state: value("byAttribute"),
+			plan:  value("byAttribute"),
+		},
+		"destroy on older server": {
+			data:  &providerData{version: serverVersion{major: 1, minor: 5}, rawVersion: "1.5.1"},
+			state: value("byAttribute"),
+			plan:  null,
+		},
+		"version unavailable": {
+			data:            &providerData{versionErr: errors.New("forbidden")},
+			state:           null,
+			plan:            value("byAttribute"),
+			expectedWarning: true,
+		},
+		"unconfigured provider": {
+			state: null,
+			plan:  value("byAttribute"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: testSchema, Raw: testCase.state},
+				Plan:  tfsdk.Plan{Schema: testSchema, Raw: testCase.plan},
+			}
+
+			diags := testCase.data.requireServerVersionForPlan(ctx, req, "Proximity precision", proximityPrecisionMinVersion, path.Root("proximity_precision"))
+
+			if diags.HasError() != testCase.expectedError {
+				t.Errorf("expected error %t, got %v", testCase.expectedError, diags)
+			}
+
+			if warnings := diags.WarningsCount(); (warnings > 0) != testCase.expectedWarning {
+				t.Errorf("expected warning %t, got %v", testCase.expectedWarning, diags)
+			}
+		})
+	}
+}
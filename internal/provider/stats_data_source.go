@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-meilisearch/internal/apierror"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &statsDataSource{}
+	_ datasource.DataSourceWithConfigure = &statsDataSource{}
+)
+
+func NewStatsDataSource() datasource.DataSource {
+	return &statsDataSource{}
+}
+
+// statsDataSource defines the data source implementation.
+type statsDataSource struct {
+	client meilisearch.ServiceManager
+}
+
+type statsDataSourceModel struct {
+	DatabaseSize     types.Int64  `tfsdk:"database_size"`
+	UsedDatabaseSize types.Int64  `tfsdk:"used_database_size"`
+	LastUpdate       types.String `tfsdk:"last_update"`
+	ID               types.String `tfsdk:"id"`
+}
+
+func (d *statsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stats"
+}
+
+func (d *statsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the global statistics of the Meilisearch database. Use `meilisearch_index_stats` for the statistics of a single index.",
+		Attributes: map[string]schema.Attribute{
+			"database_size": schema.Int64Attribute{
+				Description: "Storage space claimed by Meilisearch, in bytes.",
+				Computed:    true,
+			},
+			"used_database_size": schema.Int64Attribute{
+				Description: "Storage space actually used by Meilisearch, in bytes.",
+				Computed:    true,
+			},
+			"last_update": schema.StringAttribute{
+				Description: "Date and time of the last update of the database (RFC3339, `null` if the database was never updated).",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *statsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state statsDataSourceModel
+
+	stats, err := d.client.GetStatsWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Meilisearch Stats",
+			apierror.Describe(err),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.DatabaseSize = types.Int64Value(stats.DatabaseSize)
+	state.UsedDatabaseSize = types.Int64Value(stats.UsedDatabaseSize)
+	state.LastUpdate = types.StringNull()
+
+	if !stats.LastUpdate.IsZero() {
+		state.LastUpdate = types.StringValue(stats.LastUpdate.Format(time.RFC3339))
+	}
+
+	state.ID = types.StringValue("placeholder")
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *statsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+
+	if !ok {
+		tflog.Error(ctx, "Type assertion failed when adding configured client to the data source")
+		return
+	}
+
+	d.client = data.client
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "meilisearch_stats" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.meilisearch_stats.test", "database_size"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_stats.test", "used_database_size"),
+					resource.TestCheckResourceAttrSet("data.meilisearch_stats.test", "last_update"),
+					// Verify ID placeholder attribute is set
+					resource.TestCheckResourceAttr("data.meilisearch_stats.test", "id", "placeholder"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"terraform-provider-meilisearch/internal/apierror"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+const (
+	// defaultTaskTimeout is used when no value is set in the timeouts block.
+	defaultTaskTimeout = 20 * time.Minute
+
+	// Bounds of the exponential backoff used when polling tasks.
+	taskPollMinInterval = 50 * time.Millisecond
+	taskPollMaxInterval = 2 * time.Second
+)
+
+// waitForTask polls a Meilisearch task until it reaches a final status. The
+// deadline is taken from ctx, which is expected to carry the timeout of the
+// current operation. Tasks ending in another status than succeeded are
+// reported as error diagnostics.
+func waitForTask(ctx context.Context, client meilisearch.ServiceManager, taskInfo *meilisearch.TaskInfo) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	interval := taskPollMinInterval
+
+	for {
+		task, err := client.GetTaskWithContext(ctx, taskInfo.TaskUID)
+		if err != nil {
+			if ctx.Err() != nil {
+				diags.Append(taskTimeoutDiagnostic(ctx, taskInfo, string(taskInfo.Status)))
+				return diags
+			}
+
+			diags.AddError(
+				"Error Fetching Meilisearch Task",
+				fmt.Sprintf("Could not fetch task %d, unexpected error: %s", taskInfo.TaskUID, apierror.Describe(err)),
+			)
+			return diags
+		}
+
+		switch task.Status {
+		case meilisearch.TaskStatusSucceeded:
+			return diags
+		case meilisearch.TaskStatusFailed, meilisearch.TaskStatusCanceled:
+			diags.Append(taskFailureDiagnostic(task))
+			return diags
+		}
+
+		tflog.Debug(ctx, "Waiting for Meilisearch task", map[string]any{
+			"task_uid": task.UID,
+			"type":     string(task.Type),
+			"status":   string(task.Status),
+		})
+
+		select {
+		case <-ctx.Done():
+			diags.Append(taskTimeoutDiagnostic(ctx, taskInfo, string(task.Status)))
+			return diags
+		case <-time.After(interval):
+		}
+
+		interval = min(interval*2, taskPollMaxInterval)
+	}
+}
+
+// waitForTasks waits for each task in order and stops at the first failure.
+func waitForTasks(ctx context.Context, client meilisearch.ServiceManager, tasks []meilisearch.TaskInfo) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i := range tasks {
+		diags.Append(waitForTask(ctx, client, &tasks[i])...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func taskFailureDiagnostic(task *meilisearch.Task) diag.Diagnostic {
+	var detail strings.Builder
+
+	fmt.Fprintf(&detail, "Task %d (%s", task.UID, task.Type)
+	if task.IndexUID != "" {
+		fmt.Fprintf(&detail, " on index %q", task.IndexUID)
+	}
+	fmt.Fprintf(&detail, ") ended with status %q", task.Status)
+
+	if task.Status == meilisearch.TaskStatusCanceled && task.CanceledBy != 0 {
+		fmt.Fprintf(&detail, ", canceled by task %d", task.CanceledBy)
+	}
+
+	if task.Error.Message != "" {
+		fmt.Fprintf(&detail, ": %s", task.Error.Message)
+	}
+
+	if task.Error.Code != "" {
+		fmt.Fprintf(&detail, "\n\nError code: %s", task.Error.Code)
+	}
+	if task.Error.Type != "" {
+		fmt.Fprintf(&detail, "\nError type: %s", task.Error.Type)
+	}
+	if task.Error.Link != "" {
+		fmt.Fprintf(&detail, "\nDocumentation: %s", task.Error.Link)
+	}
+
+	return diag.NewErrorDiagnostic(
+		fmt.Sprintf("Meilisearch Task %s", strings.ToUpper(string(task.Status[:1]))+string(task.Status[1:])),
+		detail.String(),
+	)
+}
+
+func taskTimeoutDiagnostic(ctx context.Context, taskInfo *meilisearch.TaskInfo, status string) diag.Diagnostic {
+	reason := "the operation was canceled"
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		reason = "the timeout was reached"
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Timed Out Waiting for Meilisearch Task",
+		fmt.Sprintf("Task %d (%s) was still %q when %s. "+
+			"The task keeps running on the Meilisearch server; consider increasing the value in the timeouts block.",
+			taskInfo.TaskUID, taskInfo.Type, status, reason),
+	)
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/meilisearch/meilisearch-go"
+)
+
+func newTaskTestServer(t *testing.T, statuses []string, body string) meilisearch.ServiceManager {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1)) - 1
+		status := statuses[min(call, len(statuses)-1)]
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"uid": 42, "indexUid": "movies", "type": "settingsUpdate", "status": "` + status + `"` + body + `}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return meilisearch.New(server.URL, meilisearch.WithAPIKey("key"))
+}
+
+func TestWaitForTaskSucceeded(t *testing.T) {
+	client := newTaskTestServer(t, []string{"enqueued", "processing", "succeeded"}, "")
+
+	diags := waitForTask(context.Background(), client, &meilisearch.TaskInfo{TaskUID: 42})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestWaitForTaskFailed(t *testing.T) {
+	client := newTaskTestServer(t, []string{"failed"}, `, "error": {
+		"message": "Attribute is not filterable.",
+		"code": "invalid_settings_filterable_attributes",
+		"type": "invalid_request",
+		"link": "https://docs.meilisearch.com/errors#invalid_settings_filterable_attributes"
+	}`)
+
+	diags := waitForTask(context.Background(), client, &meilisearch.TaskInfo{TaskUID: 42})
+
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+
+	detail := diags.Errors()[0].Detail()
+	for _, expected := range []string{
+		"Attribute is not filterable.",
+		"Error code: invalid_settings_filterable_attributes",
+		"Error type: invalid_request",
+		"Documentation: https://docs.meilisearch.com/errors#invalid_settings_filterable_attributes",
+	} {
+		if !strings.Contains(detail, expected) {
+			t.Errorf("expected %q in diagnostic detail, got: %s", expected, detail)
+		}
+	}
+}
+
+func TestWaitForTaskCanceled(t *testing.T) {
+	client := newTaskTestServer(t, []string{"canceled"}, `, "canceledBy": 43`)
+
+	diags := waitForTask(context.Background(), client, &meilisearch.TaskInfo{TaskUID: 42})
+
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+
+	if summary := diags.Errors()[0].Summary(); summary != "Meilisearch Task Canceled" {
+		t.Errorf("unexpected summary: %s", summary)
+	}
+
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "canceled by task 43") {
+		t.Errorf("expected canceling task in diagnostic detail, got: %s", detail)
+	}
+}
+
+func TestWaitForTaskTimeout(t *testing.T) {
+	client := newTaskTestServer(t, []string{"processing"}, "")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	diags := waitForTask(ctx, client, &meilisearch.TaskInfo{TaskUID: 42})
+
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+
+	if summary := diags.Errors()[0].Summary(); summary != "Timed Out Waiting for Meilisearch Task" {
+		t.Errorf("unexpected summary: %s", summary)
+	}
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/meilisearch/meilisearch-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &tenantTokenFunction{}
+
+// NewTenantTokenFunction is a helper function to simplify the provider implementation.
+func NewTenantTokenFunction() function.Function {
+	return &tenantTokenFunction{}
+}
+
+// tenantTokenFunction is the function implementation.
+type tenantTokenFunction struct{}
+
+// Metadata returns the function name.
+func (f *tenantTokenFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tenant_token"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *tenantTokenFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generates a Meilisearch tenant token.",
+		MarkdownDescription: "Generates a [tenant token](https://www.meilisearch.com/docs/learn/security/multitenancy_tenant_tokens) " +
+			"signed with a parent API key, restricting searches to the given search rules. " +
+			"The token is generated offline, without calling the Meilisearch server.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "api_key_uid",
+				Description: "UID (uuid v4) of the API key used to sign the token.",
+			},
+			function.StringParameter{
+				Name:        "api_key",
+				Description: "Actual value of the API key used to sign the token. It must have the `search` action.",
+			},
+			function.DynamicParameter{
+				Name: "search_rules",
+				Description: "Search rules applied to the token: either a list of index UIDs (or patterns such as `tenant_*`), " +
+					"or an object mapping each index UID or pattern to `null` or to an object with an optional `filter`, " +
+					"for instance `{ movies = { filter = \"user_id = 1\" } }`.",
+			},
+			function.StringParameter{
+				Name:           "expires_at",
+				Description:    "Date and time when the token expires (RFC3339), or `null` for a token that does not expire.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run generates the token.
+func (f *tenantTokenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var apiKeyUID, apiKey, expiresAt types.String
+	var searchRules types.Dynamic
+
+	resp.Error = req.Arguments.Get(ctx, &apiKeyUID, &apiKey, &searchRules, &expiresAt)
+	if resp.Error != nil {
+		return
+	}
+
+	if !meilisearch.IsValidUUID(apiKeyUID.ValueString()) {
+		resp.Error = function.NewArgumentFuncError(0, "api_key_uid must be a valid uuid v4.")
+		return
+	}
+
+	if apiKey.ValueString() == "" {
+		resp.Error = function.NewArgumentFuncError(1, "api_key must not be empty.")
+		return
+	}
+
+	rules, err := expandSearchRules(searchRules.UnderlyingValue())
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, "Invalid search rules: "+err.Error())
+		return
+	}
+
+	options := &meilisearch.TenantTokenOptions{
+		APIKey: apiKey.ValueString(),
+	}
+
+	if !expiresAt.IsNull() {
+		options.ExpiresAt, err = time.Parse(time.RFC3339, expiresAt.ValueString())
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(3, "expires_at must be a RFC3339 date: "+err.Error())
+			return
+		}
+
+		if !options.ExpiresAt.After(time.Now()) {
+			resp.Error = function.NewArgumentFuncError(3, "expires_at must be in the future.")
+			return
+		}
+	}
+
+	// Tokens are signed locally, so the client never reaches the host
+	token, err := meilisearch.New("http://localhost").GenerateTenantToken(apiKeyUID.ValueString(), rules, options)
+	if err != nil {
+		resp.Error = function.NewFuncError("Could not generate tenant token: " + err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, types.StringValue(token))
+}
+
+// expandSearchRules converts and validates search rules, given either as a
+// list of index UIDs or as an object mapping index UIDs to their rule.
+func expandSearchRules(value attr.Value) (map[string]any, error) {
+	if value == nil || value.IsNull() {
+		return nil, fmt.Errorf("search rules must not be null")
+	}
+
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("search rules must be known")
+	}
+
+	rules := map[string]any{}
+
+	switch value := value.(type) {
+	case types.List, types.Set, types.Tuple:
+		for i, element := range collectionElements(value) {
+			index, ok := element.(types.String)
+			if !ok || index.IsNull() {
+				return nil, fmt.Errorf("element %d must be an index UID", i)
+			}
+
+			rules[index.ValueString()] = nil
+		}
+	case types.Object, types.Map:
+		for index, element := range mappingElements(value) {
+			rule, err := expandSearchRule(element)
+			if err != nil {
+				return nil, fmt.Errorf("rule of %q %w", index, err)
+			}
+
+			rules[index] = rule
+		}
+	default:
+		return nil, fmt.Errorf("search rules must be a list of index UIDs or an object, got %s", value.Type(context.Background()))
+	}
+
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("at least one index must be set")
+	}
+
+	return rules, nil
+}
+
+// expandSearchRule converts the rule of a single index, which is either null
+// or an object with an optional filter.
+func expandSearchRule(value attr.Value) (any, error) {
+	if dynamic, ok := value.(types.Dynamic); ok {
+		value = dynamic.UnderlyingValue()
+	}
+
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+
+	switch value.(type) {
+	case types.Object, types.Map:
+	default:
+		return nil, fmt.Errorf("must be null or an object")
+	}
+
+	rule := map[string]any{}
+
+	for name, element := range mappingElements(value) {
+		if name != "filter" {
+			return nil, fmt.Errorf("has unsupported attribute %q, only filter is allowed", name)
+		}
+
+		filter, err := expandSearchRuleFilter(element, 0)
+		if err != nil {
+			return nil, fmt.Errorf("filter %w", err)
+		}
+
+		if filter != nil {
+			rule["filter"] = filter
+		}
+	}
+
+	return rule, nil
+}
+
+// expandSearchRuleFilter converts a filter, which is either a string or a
+// list whose elements are strings or lists of strings.
+func expandSearchRuleFilter(value attr.Value, depth int) (any, error) {
+	if dynamic, ok := value.(types.Dynamic); ok {
+		value = dynamic.UnderlyingValue()
+	}
+
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+
+	switch value := value.(type) {
+	case types.String:
+		return value.ValueString(), nil
+	case types.List, types.Set, types.Tuple:
+		if depth > 1 {
+			return nil, fmt.Errorf("must only nest lists of strings once")
+		}
+
+		var filters []any
+
+		for _, element := range collectionElements(value) {
+			filter, err := expandSearchRuleFilter(element, depth+1)
+			if err != nil {
+				return nil, err
+			}
+
+			filters = append(filters, filter)
+		}
+
+		return filters, nil
+	default:
+		return nil, fmt.Errorf("must be a string or a list of strings")
+	}
+}
+
+func collectionElements(value attr.Value) []attr.Value {
+	switch value := value.(type) {
+	case types.List:
+		return value.Elements()
+	case types.Set:
+		return value.Elements()
+	case types.Tuple:
+		return value.Elements()
+	default:
+		return nil
+	}
+}
+
+func mappingElements(value attr.Value) map[string]attr.Value {
+	switch value := value.(type) {
+	case types.Object:
+		return value.Attributes()
+	case types.Map:
+		return value.Elements()
+	default:
+		return nil
+	}
+}
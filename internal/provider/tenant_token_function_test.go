@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTenantTokenFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "token" {
+	value = provider::meilisearch::tenant_token(
+		"76cf8b87-fd12-4688-ad34-260d930ca4f4",
+		"parent-key",
+		{ movies = { filter = "user_id = 1" } },
+		"2042-04-02T00:42:42Z",
+	)
+}
+`,
+				Check: resource.TestMatchOutput("token", regexp.MustCompile(`^[\w-]+\.[\w-]+\.[\w-]+$`)),
+			},
+			{
+				Config: `
+output "token" {
+	value = provider::meilisearch::tenant_token("76cf8b87-fd12-4688-ad34-260d930ca4f4", "parent-key", { movies = { sort = "id" } }, null)
+}
+`,
+				ExpectError: regexp.MustCompile(`unsupported attribute "sort"`),
+			},
+		},
+	})
+}
+
+func TestTenantTokenFunctionRun(t *testing.T) {
+	searchRules := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"movies":   types.ObjectType{AttrTypes: map[string]attr.Type{"filter": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.TupleType{ElemTypes: []attr.Type{types.StringType}}}}}},
+			"tenant_*": types.DynamicType,
+		},
+		map[string]attr.Value{
+			"movies": types.ObjectValueMust(
+				map[string]attr.Type{"filter": types.TupleType{ElemTypes: []attr.Type{types.StringType, types.TupleType{ElemTypes: []attr.Type{types.StringType}}}}},
+				map[string]attr.Value{"filter": types.TupleValueMust(
+					[]attr.Type{types.StringType, types.TupleType{ElemTypes: []attr.Type{types.StringType}}},
+					[]attr.Value{types.StringValue("user_id = 1"), types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("public = true")})},
+				)},
+			),
+			"tenant_*": types.DynamicNull(),
+		},
+	))
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("76cf8b87-fd12-4688-ad34-260d930ca4f4"),
+			types.StringValue("parent-key"),
+			searchRules,
+			types.StringValue("2042-04-02T00:42:42Z"),
+		}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	(&tenantTokenFunction{}).Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	token := resp.Result.Value().(types.String).ValueString()
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("unexpected token %q", token)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var claims map[string]any
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]any{
+		"apiKeyUid": "76cf8b87-fd12-4688-ad34-260d930ca4f4",
+		"exp":       float64(2280012162),
+		"searchRules": map[string]any{
+			"movies":   map[string]any{"filter": []any{"user_id = 1", []any{"public = true"}}},
+			"tenant_*": nil,
+		},
+	}
+
+	if !reflect.DeepEqual(claims, expected) {
+		t.Errorf("unexpected claims %v", claims)
+	}
+}
+
+func TestExpandSearchRules(t *testing.T) {
+	rules, err := expandSearchRules(types.TupleValueMust(
+		[]attr.Type{types.StringType, types.StringType},
+		[]attr.Value{types.StringValue("movies"), types.StringValue("tenant_*")},
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(rules, map[string]any{"movies": nil, "tenant_*": nil}) {
+		t.Errorf("unexpected rules %v", rules)
+	}
+
+	for name, value := range map[string]attr.Value{
+		"string": types.StringValue("movies"),
+		"empty":  types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+		"number": types.TupleValueMust([]attr.Type{types.NumberType}, []attr.Value{types.NumberNull()}),
+		"rule": types.ObjectValueMust(
+			map[string]attr.Type{"movies": types.StringType},
+			map[string]attr.Value{"movies": types.StringValue("user_id = 1")},
+		),
+	} {
+		if _, err := expandSearchRules(value); err == nil {
+			t.Errorf("expected an error for %s search rules", name)
+		}
+	}
+}
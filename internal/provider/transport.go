@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// transportConfig holds the HTTP transport settings of the provider, once
+// merged with their environment variables.
+type transportConfig struct {
+	caCertPEM          string
+	caCertFile         string
+	clientCert         string
+	clientKey          string
+	insecureSkipVerify bool
+	proxyURL           string
+	headers            map[string]string
+
+	requestTimeout       time.Duration
+	maxRetries           int
+	retryBackoff         time.Duration
+	retryMaxBackoff      time.Duration
+	retryableStatusCodes []int
+	maxRequestsPerSecond float64
+}
+
+// newTransportConfig merges the transport settings of the provider
+// configuration with their environment variables and defaults.
+func newTransportConfig(ctx context.Context, config MeilisearchProviderModel) (transportConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transport := transportConfig{
+		caCertPEM:            configuredString(config.CACertPEM, "MEILISEARCH_CA_CERT_PEM"),
+		caCertFile:           configuredString(config.CACertFile, "MEILISEARCH_CA_CERT_FILE"),
+		clientCert:           configuredString(config.ClientCert, "MEILISEARCH_CLIENT_CERT"),
+		clientKey:            configuredString(config.ClientKey, "MEILISEARCH_CLIENT_KEY"),
+		insecureSkipVerify:   configuredBool(config.InsecureSkipVerify, "insecure_skip_verify", "MEILISEARCH_INSECURE_SKIP_VERIFY", &diags),
+		proxyURL:             configuredString(config.ProxyURL, "MEILISEARCH_PROXY_URL"),
+		requestTimeout:       configuredDuration(config.RequestTimeout, "request_timeout", "MEILISEARCH_REQUEST_TIMEOUT", 0, &diags),
+		maxRetries:           int(configuredInt64(config.MaxRetries, "max_retries", "MEILISEARCH_MAX_RETRIES", defaultMaxRetries, &diags)),
+		retryBackoff:         configuredDuration(config.RetryBackoff, "retry_backoff", "MEILISEARCH_RETRY_BACKOFF", defaultRetryBackoff, &diags),
+		retryMaxBackoff:      configuredDuration(config.RetryMaxBackoff, "retry_max_backoff", "MEILISEARCH_RETRY_MAX_BACKOFF", defaultRetryMaxBackoff, &diags),
+		retryableStatusCodes: defaultRetryableStatusCodes,
+		maxRequestsPerSecond: configuredFloat64(config.MaxRequestsPerSecond, "max_requests_per_second", "MEILISEARCH_MAX_REQUESTS_PER_SECOND", &diags),
+	}
+
+	// Settings configured together replace all their environment variables
+	if !config.CACertPEM.IsNull() || !config.CACertFile.IsNull() {
+		transport.caCertPEM = config.CACertPEM.ValueString()
+		transport.caCertFile = config.CACertFile.ValueString()
+	}
+
+	if !config.ClientCert.IsNull() || !config.ClientKey.IsNull() {
+		transport.clientCert = config.ClientCert.ValueString()
+		transport.clientKey = config.ClientKey.ValueString()
+	}
+
+	if !config.Headers.IsNull() {
+		diags.Append(config.Headers.ElementsAs(ctx, &transport.headers, false)...)
+	} else if value := os.Getenv("MEILISEARCH_HEADERS"); value != "" {
+		if err := json.Unmarshal([]byte(value), &transport.headers); err != nil {
+			diags.AddAttributeError(
+				path.Root("headers"),
+				"Invalid Meilisearch headers",
+				"The MEILISEARCH_HEADERS environment variable must be a JSON object of header names and values: "+err.Error(),
+			)
+		}
+	}
+
+	if !config.RetryableStatusCodes.IsNull() {
+		transport.retryableStatusCodes = nil
+		diags.Append(config.RetryableStatusCodes.ElementsAs(ctx, &transport.retryableStatusCodes, false)...)
+	} else if value := os.Getenv("MEILISEARCH_RETRYABLE_STATUS_CODES"); value != "" {
+		transport.retryableStatusCodes = nil
+
+		for _, code := range strings.Split(value, ",") {
+			statusCode, err := strconv.Atoi(strings.TrimSpace(code))
+			if err != nil || statusCode < 100 || statusCode > 599 {
+				diags.AddAttributeError(
+					path.Root("retryable_status_codes"),
+					"Invalid Meilisearch retryable_status_codes setting",
+					"The MEILISEARCH_RETRYABLE_STATUS_CODES environment variable must be a comma-separated list of HTTP status codes, got "+strconv.Quote(value)+".",
+				)
+				break
+			}
+
+			transport.retryableStatusCodes = append(transport.retryableStatusCodes, statusCode)
+		}
+	}
+
+	if diags.HasError() {
+		return transport, diags
+	}
+
+	if transport.maxRetries < 0 || transport.maxRequestsPerSecond < 0 || transport.retryBackoff <= 0 || transport.retryMaxBackoff < transport.retryBackoff {
+		diags.AddError(
+			"Invalid Meilisearch retry settings",
+			"max_retries and max_requests_per_second cannot be negative, retry_backoff must be positive and retry_max_backoff cannot be lower than retry_backoff.",
+		)
+	}
+
+	return transport, diags
+}
+
+// configuredString returns the configuration value when set, and the value of
+// the environment variable otherwise.
+func configuredString(value types.String, variable string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(variable)
+}
+
+// configuredBool returns the configuration value when set, and the value of
+// the environment variable otherwise, defaulting to false.
+func configuredBool(value types.Bool, attribute, variable string, diags *diag.Diagnostics) bool {
+	if !value.IsNull() {
+		return value.ValueBool()
+	}
+
+	env := os.Getenv(variable)
+	if env == "" {
+		return false
+	}
+
+	parsed, err := strconv.ParseBool(env)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Meilisearch "+attribute+" setting",
+			"The "+variable+" environment variable must be a boolean, got "+strconv.Quote(env)+".",
+		)
+	}
+
+	return parsed
+}
+
+// configuredInt64 returns the configuration value when set, and the value of
+// the environment variable otherwise, defaulting to defaultValue.
+func configuredInt64(value types.Int64, attribute, variable string, defaultValue int64, diags *diag.Diagnostics) int64 {
+	if !value.IsNull() {
+		return value.ValueInt64()
+	}
+
+	env := os.Getenv(variable)
+	if env == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Meilisearch "+attribute+" setting",
+			"The "+variable+" environment variable must be an integer, got "+strconv.Quote(env)+".",
+		)
+	}
+
+	return parsed
+}
+
+// configuredFloat64 returns the configuration value when set, and the value of
+// the environment variable otherwise, defaulting to 0.
+func configuredFloat64(value types.Float64, attribute, variable string, diags *diag.Diagnostics) float64 {
+	if !value.IsNull() {
+		return value.ValueFloat64()
+	}
+
+	env := os.Getenv(variable)
+	if env == "" {
+		return 0
+	}
+
+	parsed, err := strconv.ParseFloat(env, 64)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Meilisearch "+attribute+" setting",
+			"The "+variable+" environment variable must be a number, got "+strconv.Quote(env)+".",
+		)
+	}
+
+	return parsed
+}
+
+// configuredDuration parses the configuration value when set, and the value
+// of the environment variable otherwise, defaulting to defaultValue.
+func configuredDuration(value types.String, attribute, variable string, defaultValue time.Duration, diags *diag.Diagnostics) time.Duration {
+	raw := configuredString(value, variable)
+	if raw == "" {
+		return defaultValue
+	}
+
+	parsed, err := time.ParseDuration(raw)
+	if err != nil || parsed < 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Meilisearch "+attribute+" setting",
+			"The "+attribute+" setting must be a positive duration such as 30s or 500ms, got "+strconv.Quote(raw)+".",
+		)
+	}
+
+	return parsed
+}
+
+// newHTTPClient builds the HTTP client used by the Meilisearch client. Without
+// any setting, it behaves like the default client of the SDK: system CA pool
+// and proxy taken from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY variables.
+func newHTTPClient(config transportConfig) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Explicitly requested by the user, typically for test servers
+		InsecureSkipVerify: config.insecureSkipVerify,
+	}
+
+	if config.caCertPEM != "" && config.caCertFile != "" {
+		diags.AddAttributeError(
+			path.Root("ca_cert_pem"),
+			"Conflicting Meilisearch CA Certificates",
+			"Only one of ca_cert_pem and ca_cert_file (or their MEILISEARCH_CA_CERT_PEM and MEILISEARCH_CA_CERT_FILE environment variables) can be set.",
+		)
+		return nil, diags
+	}
+
+	caCertPEM, caCertPath := []byte(config.caCertPEM), path.Root("ca_cert_pem")
+
+	if config.caCertFile != "" {
+		var err error
+
+		caCertPath = path.Root("ca_cert_file")
+
+		caCertPEM, err = os.ReadFile(config.caCertFile)
+		if err != nil {
+			diags.AddAttributeError(
+				caCertPath,
+				"Unable to Read Meilisearch CA Certificate",
+				"Could not read the CA certificate file: "+err.Error(),
+			)
+			return nil, diags
+		}
+	}
+
+	if len(caCertPEM) > 0 {
+		// Trust the given CA in addition to the system ones
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(caCertPEM) {
+			diags.AddAttributeError(
+				caCertPath,
+				"Invalid Meilisearch CA Certificate",
+				"The CA certificate does not contain any PEM encoded certificate.",
+			)
+			return nil, diags
+		}
+
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if (config.clientCert == "") != (config.clientKey == "") {
+		diags.AddAttributeError(
+			path.Root("client_cert"),
+			"Incomplete Meilisearch Client Certificate",
+			"Both client_cert and client_key (or their MEILISEARCH_CLIENT_CERT and MEILISEARCH_CLIENT_KEY environment variables) must be set to authenticate with a client certificate.",
+		)
+		return nil, diags
+	}
+
+	if config.clientCert != "" {
+		certificate, err := tls.X509KeyPair([]byte(config.clientCert), []byte(config.clientKey))
+		if err != nil {
+			// The error never contains the key material
+			diags.AddAttributeError(
+				path.Root("client_cert"),
+				"Invalid Meilisearch Client Certificate",
+				"Could not load the client certificate and key: "+err.Error(),
+			)
+			return nil, diags
+		}
+
+		transport.TLSClientConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if config.proxyURL != "" {
+		proxyURL, err := url.Parse(config.proxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			diags.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Meilisearch Proxy URL",
+				"The proxy URL must be an absolute URL such as http://proxy.example.com:3128.",
+			)
+			return nil, diags
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	var roundTripper http.RoundTripper = transport
+
+	if len(config.headers) > 0 {
+		roundTripper = &headerTransport{base: roundTripper, headers: config.headers}
+	}
+
+	retry := &retryTransport{
+		base:                 roundTripper,
+		requestTimeout:       config.requestTimeout,
+		maxRetries:           config.maxRetries,
+		backoff:              config.retryBackoff,
+		maxBackoff:           config.retryMaxBackoff,
+		retryableStatusCodes: make(map[int]bool, len(config.retryableStatusCodes)),
+	}
+
+	for _, statusCode := range config.retryableStatusCodes {
+		retry.retryableStatusCodes[statusCode] = true
+	}
+
+	if config.maxRequestsPerSecond > 0 {
+		retry.limiter = newRateLimiter(config.maxRequestsPerSecond)
+	}
+
+	return &http.Client{Transport: retry}, diags
+}
+
+// headerTransport adds custom headers to every request, without overriding
+// the headers set by the Meilisearch client such as Authorization.
+type headerTransport struct {
+	base    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the original request
+	req = req.Clone(req.Context())
+
+	for name, value := range t.headers {
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
+
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTransportTestServer starts a TLS server echoing the X-Test and
+// Authorization request headers.
+func newTransportTestServer(t *testing.T, configure func(*tls.Config)) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("X-Test") + "|" + r.Header.Get("Authorization")))
+	}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.TLS = &tls.Config{}
+	if configure != nil {
+		configure(server.TLS)
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func serverCertificatePEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+// newClientCertificate generates a self-signed client certificate and key.
+func newClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return certificate, string(certPEM), string(keyPEM)
+}
+
+func getWithClient(client *http.Client, url string, header http.Header) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header = header
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+
+	return string(body), err
+}
+
+func TestNewHTTPClientTLS(t *testing.T) {
+	server := newTransportTestServer(t, nil)
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte(serverCertificatePEM(server)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		config        transportConfig
+		expectSuccess bool
+	}{
+		"system CA pool": {
+			config: transportConfig{},
+		},
+		"CA certificate PEM": {
+			config:        transportConfig{caCertPEM: serverCertificatePEM(server)},
+			expectSuccess: true,
+		},
+		"CA certificate file": {
+			config:        transportConfig{caCertFile: caCertFile},
+			expectSuccess: true,
+		},
+		"insecure skip verify": {
+			config:        transportConfig{insecureSkipVerify: true},
+			expectSuccess: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client, diags := newHTTPClient(testCase.config)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			_, err := getWithClient(client, server.URL, http.Header{})
+			if success := err == nil; success != testCase.expectSuccess {
+				t.Errorf("expected request success to be %t, got error: %v", testCase.expectSuccess, err)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientClientCertificate(t *testing.T) {
+	certificate, certPEM, keyPEM := newClientCertificate(t)
+
+	server := newTransportTestServer(t, func(config *tls.Config) {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = x509.NewCertPool()
+		config.ClientCAs.AddCert(certificate)
+	})
+
+	client, diags := newHTTPClient(transportConfig{caCertPEM: serverCertificatePEM(server)})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if _, err := getWithClient(client, server.URL, http.Header{}); err == nil {
+		t.Error("expected request without client certificate to fail")
+	}
+
+	client, diags = newHTTPClient(transportConfig{caCertPEM: serverCertificatePEM(server), clientCert: certPEM, clientKey: keyPEM})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if _, err := getWithClient(client, server.URL, http.Header{}); err != nil {
+		t.Errorf("unexpected error with client certificate: %s", err)
+	}
+}
+
+func TestNewHTTPClientHeaders(t *testing.T) {
+	server := newTransportTestServer(t, nil)
+
+	client, diags := newHTTPClient(transportConfig{
+		insecureSkipVerify: true,
+		headers:            map[string]string{"X-Test": "custom", "Authorization": "Bearer custom"},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	body, err := getWithClient(client, server.URL, http.Header{"Authorization": []string{"Bearer key"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := "custom|Bearer key"; body != expected {
+		t.Errorf("expected headers %q, got %q", expected, body)
+	}
+}
+
+func TestNewHTTPClientInvalidConfig(t *testing.T) {
+	_, certPEM, keyPEM := newClientCertificate(t)
+
+	testCases := map[string]struct {
+		config          transportConfig
+		expectedSummary string
+	}{
+		"conflicting CA certificates": {
+			config:          transportConfig{caCertPEM: "pem", caCertFile: "ca.pem"},
+			expectedSummary: "Conflicting Meilisearch CA Certificates",
+		},
+		"missing CA certificate file": {
+			config:          transportConfig{caCertFile: filepath.Join(t.TempDir(), "missing.pem")},
+			expectedSummary: "Unable to Read Meilisearch CA Certificate",
+		},
+		"invalid CA certificate": {
+			config:          transportConfig{caCertPEM: "not a certificate"},
+			expectedSummary: "Invalid Meilisearch CA Certificate",
+		},
+		"client certificate without key": {
+			config:          transportConfig{clientCert: certPEM},
+			expectedSummary: "Incomplete Meilisearch Client Certificate",
+		},
+		"mismatched client key": {
+			config:          transportConfig{clientCert: certPEM, clientKey: keyPEM[:len(keyPEM)/2]},
+			expectedSummary: "Invalid Meilisearch Client Certificate",
+		},
+		"relative proxy URL": {
+			config:          transportConfig{proxyURL: "proxy.example.com"},
+			expectedSummary: "Invalid Meilisearch Proxy URL",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, diags := newHTTPClient(testCase.config)
+			if !diags.HasError() {
+				t.Fatal("expected an error")
+			}
+
+			if summary := diags[0].Summary(); summary != testCase.expectedSummary {
+				t.Errorf("expected summary %q, got %q", testCase.expectedSummary, summary)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientProxy(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("proxied " + r.URL.Host))
+	}))
+	t.Cleanup(proxy.Close)
+
+	client, diags := newHTTPClient(transportConfig{proxyURL: proxy.URL})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	body, err := getWithClient(client, "http://meilisearch.internal:7700/health", http.Header{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := "proxied meilisearch.internal:7700"; body != expected {
+		t.Errorf("expected %q, got %q", expected, body)
+	}
+}
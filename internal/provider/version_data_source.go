@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"terraform-provider-meilisearch/internal/apierror"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -63,11 +64,11 @@ func (d *versionDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 func (d *versionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state versionDataSourceModel
 
-	version, err := d.client.Version()
+	version, err := d.client.VersionWithContext(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Meilisearch Version",
-			err.Error(),
+			apierror.Describe(err),
 		)
 		return
 	}
@@ -97,11 +98,12 @@ func (d *versionDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	var ok bool
-
-	d.client, ok = req.ProviderData.(meilisearch.ServiceManager)
+	data, ok := req.ProviderData.(*providerData)
 
 	if !ok {
 		tflog.Error(ctx, "Type assertion failed when adding configured client to the data source")
+		return
 	}
+
+	d.client = data.client
 }